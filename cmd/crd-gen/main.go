@@ -0,0 +1,245 @@
+// crd-gen generates the CustomResourceDefinitions for the Terraform custom resources from the Go types in pkg/types.
+//
+// The CRD documents in each of the given manifest files are replaced in place, all other documents are left unchanged:
+//
+//	go run ./cmd/crd-gen manifests/terraform-operator.yaml manifests/native/terraform-operator.yaml
+//
+// With -check, the files are not written and the command fails if any of them are out of date.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+const docSeparator = "---\n"
+
+// CustomResourceDefinition is the apiextensions.k8s.io/v1beta1 CRD, limited to the fields used by the Terraform resources.
+type CustomResourceDefinition struct {
+	APIVersion string                       `json:"apiVersion"`
+	Kind       string                       `json:"kind"`
+	Metadata   CustomResourceMetadata       `json:"metadata"`
+	Spec       CustomResourceDefinitionSpec `json:"spec"`
+}
+
+// CustomResourceMetadata is the metadata of the CRD.
+type CustomResourceMetadata struct {
+	Name string `json:"name"`
+}
+
+// CustomResourceDefinitionSpec is the spec of the CRD.
+type CustomResourceDefinitionSpec struct {
+	Group                    string                            `json:"group"`
+	Version                  string                            `json:"version"`
+	Versions                 []CustomResourceDefinitionVersion `json:"versions"`
+	Scope                    string                            `json:"scope"`
+	Names                    CustomResourceDefinitionNames     `json:"names"`
+	Subresources             CustomResourceSubresources        `json:"subresources"`
+	Validation               CustomResourceValidation          `json:"validation"`
+	AdditionalPrinterColumns []CustomResourceColumnDefinition  `json:"additionalPrinterColumns"`
+}
+
+// CustomResourceDefinitionVersion is a version of the API served by the CRD.
+type CustomResourceDefinitionVersion struct {
+	Name    string `json:"name"`
+	Served  bool   `json:"served"`
+	Storage bool   `json:"storage"`
+}
+
+// CustomResourceDefinitionNames are the names of the resource.
+type CustomResourceDefinitionNames struct {
+	Plural     string   `json:"plural"`
+	Singular   string   `json:"singular"`
+	Kind       string   `json:"kind"`
+	ShortNames []string `json:"shortNames"`
+}
+
+// CustomResourceSubresources enables the status subresource.
+type CustomResourceSubresources struct {
+	Status map[string]interface{} `json:"status"`
+}
+
+// CustomResourceValidation is the OpenAPI v3 validation schema.
+type CustomResourceValidation struct {
+	OpenAPIV3Schema JSONSchemaProps `json:"openAPIV3Schema"`
+}
+
+// CustomResourceColumnDefinition is an additional column shown by kubectl get.
+type CustomResourceColumnDefinition struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Format      string `json:"format,omitempty"`
+	Description string `json:"description,omitempty"`
+	Priority    int32  `json:"priority,omitempty"`
+	JSONPath    string `json:"JSONPath"`
+}
+
+var printerColumns = []CustomResourceColumnDefinition{
+	{
+		Name:        "Ready",
+		Type:        "string",
+		Description: "Status of the Ready condition",
+		JSONPath:    fmt.Sprintf(".status.conditions[?(@.type==\"%s\")].status", tfv1.ConditionReady),
+	},
+	{
+		Name:        "PodStatus",
+		Type:        "string",
+		Description: "Status of the pod for the last run",
+		JSONPath:    ".status.podStatus",
+	},
+	{
+		Name:        "Added",
+		Type:        "integer",
+		Description: "Resources to add in the plan",
+		JSONPath:    ".status.planDiff.added",
+	},
+	{
+		Name:        "Changed",
+		Type:        "integer",
+		Description: "Resources to change in the plan",
+		JSONPath:    ".status.planDiff.changed",
+	},
+	{
+		Name:        "Destroyed",
+		Type:        "integer",
+		Description: "Resources to destroy in the plan",
+		JSONPath:    ".status.planDiff.destroyed",
+	},
+	{
+		Name:     "Age",
+		Type:     "date",
+		JSONPath: ".metadata.creationTimestamp",
+	},
+}
+
+func main() {
+	check := flag.Bool("check", false, "Do not write the manifests, exit non-zero if any are out of date.")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		log.Fatalf("Usage: %s [-check] MANIFEST...", os.Args[0])
+	}
+
+	stale := false
+	for _, path := range flag.Args() {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Fatalf("Failed to read manifest %s: %v", path, err)
+		}
+
+		updated, err := updateManifest(data)
+		if err != nil {
+			log.Fatalf("Failed to update manifest %s: %v", path, err)
+		}
+
+		if bytes.Equal(data, updated) {
+			continue
+		}
+
+		if *check {
+			log.Printf("%s is out of date, run: go run ./cmd/crd-gen %s", path, strings.Join(flag.Args(), " "))
+			stale = true
+			continue
+		}
+
+		if err := ioutil.WriteFile(path, updated, 0644); err != nil {
+			log.Fatalf("Failed to write manifest %s: %v", path, err)
+		}
+		log.Printf("Updated %s", path)
+	}
+
+	if stale {
+		os.Exit(1)
+	}
+}
+
+// updateManifest replaces the Terraform CRDs in the multi-document manifest with the generated CRDs.
+// Comment lines at the start of a replaced document are kept.
+func updateManifest(data []byte) ([]byte, error) {
+	docs := strings.Split(string(data), docSeparator)
+	for i, doc := range docs {
+		var crd struct {
+			Kind     string                 `json:"kind"`
+			Metadata CustomResourceMetadata `json:"metadata"`
+			Spec     struct {
+				Group string                        `json:"group"`
+				Names CustomResourceDefinitionNames `json:"names"`
+			} `json:"spec"`
+		}
+		if err := yaml.Unmarshal([]byte(doc), &crd); err != nil {
+			return nil, fmt.Errorf("Failed to parse document %d: %v", i, err)
+		}
+		if crd.Kind != "CustomResourceDefinition" || crd.Spec.Group != tfv1.Group {
+			continue
+		}
+
+		kind := tfv1.TFKind(crd.Spec.Names.Kind)
+		if kind.GetPlural() == "" {
+			return nil, fmt.Errorf("Unknown kind in CRD %s: %s", crd.Metadata.Name, kind)
+		}
+
+		out, err := yaml.Marshal(makeCRD(kind))
+		if err != nil {
+			return nil, err
+		}
+
+		docs[i] = getLeadingComments(doc) + string(out)
+	}
+	return []byte(strings.Join(docs, docSeparator)), nil
+}
+
+// makeCRD returns the CRD for the given kind.
+func makeCRD(kind tfv1.TFKind) CustomResourceDefinition {
+	plural := kind.GetPlural()
+	return CustomResourceDefinition{
+		APIVersion: "apiextensions.k8s.io/v1beta1",
+		Kind:       "CustomResourceDefinition",
+		Metadata: CustomResourceMetadata{
+			Name: fmt.Sprintf("%s.%s", plural, tfv1.Group),
+		},
+		Spec: CustomResourceDefinitionSpec{
+			Group:   tfv1.Group,
+			Version: tfv1.Version,
+			// The v1alpha2 API has the same JSON format with typed fields, the objects are stored as v1.
+			Versions: []CustomResourceDefinitionVersion{
+				{Name: tfv1.Version, Served: true, Storage: true},
+				{Name: v1alpha2.SchemeGroupVersion.Version, Served: true, Storage: false},
+			},
+			Scope: "Namespaced",
+			Names: CustomResourceDefinitionNames{
+				Plural:     plural,
+				Singular:   strings.ToLower(string(kind)),
+				Kind:       string(kind),
+				ShortNames: []string{string(kind.GetShort())},
+			},
+			Subresources: CustomResourceSubresources{
+				Status: map[string]interface{}{},
+			},
+			Validation: CustomResourceValidation{
+				OpenAPIV3Schema: makeTerraformSchema(),
+			},
+			AdditionalPrinterColumns: printerColumns,
+		},
+	}
+}
+
+func getLeadingComments(doc string) string {
+	var comments string
+	for _, line := range strings.SplitAfter(doc, "\n") {
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		comments += line
+	}
+	return comments
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/ghodss/yaml"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+func TestManifestsUpToDate(t *testing.T) {
+	for _, path := range []string{
+		"../../manifests/terraform-operator.yaml",
+		"../../manifests/native/terraform-operator.yaml",
+	} {
+		t.Run(path, func(t *testing.T) {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			updated, err := updateManifest(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != string(updated) {
+				t.Errorf("CRDs in %s are out of date with pkg/types, run: go run ./cmd/crd-gen %s", path, path)
+			}
+		})
+	}
+}
+
+func TestUpdateManifest(t *testing.T) {
+	manifest := `### BEGIN TerraformPlan ###
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: terraformplans.ctl.isla.solutions
+spec:
+  group: ctl.isla.solutions
+  names:
+    kind: TerraformPlan
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: metacontroller
+`
+	updated, err := updateManifest([]byte(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crd, err := yaml.Marshal(makeCRD(tfv1.TFKindPlan))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "### BEGIN TerraformPlan ###\n" + string(crd) + `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: metacontroller
+`
+	if string(updated) != expected {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", expected, string(updated))
+	}
+}
+
+func TestMakeSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   JSONSchemaProps
+		expected JSONSchemaProps
+	}{
+		{
+			"pod status enum",
+			makeTerraformSchema().Properties["status"].Properties["podStatus"],
+			JSONSchemaProps{Type: "string", Enum: []string{"FAILED", "COMPLETED", "RUNNING", "UNKNOWN"}},
+		},
+		{
+			"condition required fields",
+			JSONSchemaProps{Required: makeSchema(reflect.TypeOf(tfv1.Condition{})).Required},
+			JSONSchemaProps{Required: []string{"type", "status"}},
+		},
+		{
+			"int32 pointer",
+			makeSchema(reflect.TypeOf(tfv1.TerraformSpec{}.MaxAttempts)),
+			JSONSchemaProps{Type: "integer", Format: "int32"},
+		},
+		{
+			"string map",
+			makeSchema(reflect.TypeOf(tfv1.TerraformBackend{}.Config)),
+			JSONSchemaProps{Type: "object", AdditionalProperties: &JSONSchemaProps{Type: "string"}},
+		},
+		{
+			"pod template",
+			makeTerraformSchema().Properties["spec"].Properties["podTemplate"],
+			JSONSchemaProps{Type: "object", PreserveUnknownFields: boolPtr(true)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.schema, tc.expected) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, tc.schema)
+			}
+		})
+	}
+}
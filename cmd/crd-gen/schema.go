@@ -0,0 +1,166 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JSONSchemaProps is the subset of the OpenAPI v3 schema used by the CRD validation.
+type JSONSchemaProps struct {
+	Type                  string                     `json:"type,omitempty"`
+	Format                string                     `json:"format,omitempty"`
+	Enum                  []string                   `json:"enum,omitempty"`
+	Required              []string                   `json:"required,omitempty"`
+	Items                 *JSONSchemaProps           `json:"items,omitempty"`
+	Properties            map[string]JSONSchemaProps `json:"properties,omitempty"`
+	AdditionalProperties  *JSONSchemaProps           `json:"additionalProperties,omitempty"`
+	Nullable              bool                       `json:"nullable,omitempty"`
+	PreserveUnknownFields *bool                      `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+}
+
+// enumValues maps the string enum types to their allowed values.
+var enumValues = map[reflect.Type][]string{
+	reflect.TypeOf(tfv1.PodStatus("")): {
+		string(tfv1.PodStatusFailed),
+		string(tfv1.PodStatusPassed),
+		string(tfv1.PodStatusRunning),
+		string(tfv1.PodStatusUnknown),
+	},
+	reflect.TypeOf(tfv1.ConditionStatus("")): {
+		string(tfv1.ConditionTrue),
+		string(tfv1.ConditionFalse),
+		string(tfv1.ConditionUnknown),
+	},
+	reflect.TypeOf(tfv1.RetryMode("")): {
+		string(tfv1.RetryModeContinuous),
+		string(tfv1.RetryModeFail),
+	},
+	reflect.TypeOf(tfv1.RetryAction("")): {
+		string(tfv1.RetryActionRetry),
+		string(tfv1.RetryActionFail),
+	},
+	reflect.TypeOf(tfv1.TerraformBackendType("")): {
+		string(tfv1.BackendGCS),
+		string(tfv1.BackendS3),
+		string(tfv1.BackendAzureRM),
+		string(tfv1.BackendKubernetes),
+		string(tfv1.BackendHTTP),
+		string(tfv1.BackendLocal),
+	},
+	reflect.TypeOf(tfv1.PlanAction("")): {
+		string(tfv1.PlanActionCreate),
+		string(tfv1.PlanActionUpdate),
+		string(tfv1.PlanActionDelete),
+		string(tfv1.PlanActionReplace),
+	},
+	reflect.TypeOf(corev1.PullPolicy("")): {
+		string(corev1.PullAlways),
+		string(corev1.PullNever),
+		string(corev1.PullIfNotPresent),
+	},
+}
+
+// opaqueTypes are embedded Kubernetes types that are validated by the API server when the child is created,
+// their schema is not expanded in the CRD.
+var opaqueTypes = map[reflect.Type]bool{
+	reflect.TypeOf(corev1.PodTemplateSpec{}): true,
+}
+
+var timeType = reflect.TypeOf(metav1.Time{})
+
+// makeTerraformSchema returns the validation schema for the Terraform custom resources.
+func makeTerraformSchema() JSONSchemaProps {
+	t := reflect.TypeOf(tfv1.Terraform{})
+
+	properties := make(map[string]JSONSchemaProps, 0)
+	for _, name := range []string{"Spec", "SpecFrom", "Status"} {
+		f, _ := t.FieldByName(name)
+		jsonName, _ := parseJSONTag(f)
+		properties[jsonName] = makeSchema(f.Type)
+	}
+
+	return JSONSchemaProps{
+		Type:       "object",
+		Properties: properties,
+	}
+}
+
+// makeSchema converts a Go type to its schema using the json struct tags.
+func makeSchema(t reflect.Type) JSONSchemaProps {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		// Unset times are serialized as null.
+		return JSONSchemaProps{Type: "string", Format: "date-time", Nullable: true}
+	}
+
+	if opaqueTypes[t] {
+		return JSONSchemaProps{Type: "object", PreserveUnknownFields: boolPtr(true)}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return JSONSchemaProps{Type: "string", Enum: enumValues[t]}
+	case reflect.Bool:
+		return JSONSchemaProps{Type: "boolean"}
+	case reflect.Int32:
+		return JSONSchemaProps{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return JSONSchemaProps{Type: "integer", Format: "int64"}
+	case reflect.Slice:
+		items := makeSchema(t.Elem())
+		return JSONSchemaProps{Type: "array", Items: &items}
+	case reflect.Map:
+		values := makeSchema(t.Elem())
+		return JSONSchemaProps{Type: "object", AdditionalProperties: &values}
+	case reflect.Struct:
+		return makeStructSchema(t)
+	}
+
+	// Fallback for types not used in the CRDs.
+	return JSONSchemaProps{PreserveUnknownFields: boolPtr(true)}
+}
+
+func makeStructSchema(t reflect.Type) JSONSchemaProps {
+	schema := JSONSchemaProps{
+		Type:       "object",
+		Properties: make(map[string]JSONSchemaProps, 0),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, omitempty := parseJSONTag(f)
+		if name == "-" || f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		schema.Properties[name] = makeSchema(f.Type)
+		if !omitempty {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// parseJSONTag returns the json field name and whether or not it has the omitempty option.
+func parseJSONTag(f reflect.StructField) (string, bool) {
+	parts := strings.Split(f.Tag.Get("json"), ",")
+	omitempty := false
+	for _, p := range parts[1:] {
+		if p == "omitempty" {
+			omitempty = true
+		}
+	}
+	return parts[0], omitempty
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
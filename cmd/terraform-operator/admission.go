@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// admissionReviewFunc returns the response to an admission request.
+type admissionReviewFunc func(req *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse
+
+// admissionHandler serves AdmissionReview requests from the API server.
+func admissionHandler(review admissionReviewFunc) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "Unsupported method\n")
+			return
+		}
+
+		var ar admissionv1beta1.AdmissionReview
+		if err := json.NewDecoder(r.Body).Decode(&ar); err != nil || ar.Request == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			log.Printf("[ERROR] Could not parse AdmissionReview: %v", err)
+			return
+		}
+
+		resp := review(ar.Request)
+		resp.UID = ar.Request.UID
+
+		data, err := json.Marshal(admissionv1beta1.AdmissionReview{
+			TypeMeta: ar.TypeMeta,
+			Response: resp,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Printf("[ERROR] Could not generate AdmissionReview: %v", err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}
+}
+
+// validateAdmission rejects Terraform objects that fail validateTerraform.
+// Updates that do not change the spec, like status and finalizer updates, are always allowed.
+func validateAdmission(req *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	var parent tfv1.Terraform
+	if err := json.Unmarshal(req.Object.Raw, &parent); err != nil {
+		return denyAdmission(fmt.Errorf("Failed to parse %s: %v", req.Kind.Kind, err))
+	}
+	parent.Kind = req.Kind.Kind
+
+	if req.Operation == admissionv1beta1.Update {
+		var old tfv1.Terraform
+		if err := json.Unmarshal(req.OldObject.Raw, &old); err == nil {
+			if reflect.DeepEqual(old.Spec, parent.Spec) && reflect.DeepEqual(old.SpecFrom, parent.SpecFrom) {
+				return &admissionv1beta1.AdmissionResponse{Allowed: true}
+			}
+		}
+	}
+
+	if parent.GetDeletionTimestamp() != nil {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	if err := validateTerraform(&parent, getTerraform); err != nil {
+		log.Printf("[INFO][%s][%s] Denied %s: %v", req.Kind.Kind, req.Name, req.Operation, err)
+		return denyAdmission(err)
+	}
+
+	return &admissionv1beta1.AdmissionResponse{Allowed: true}
+}
+
+// defaultAdmission sets the operator defaults in the spec of new Terraform objects.
+// Objects using specFrom get their spec from the referenced object and are not changed.
+func defaultAdmission(req *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	if req.Operation != admissionv1beta1.Create {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	var parent tfv1.Terraform
+	if err := json.Unmarshal(req.Object.Raw, &parent); err != nil {
+		return denyAdmission(fmt.Errorf("Failed to parse %s: %v", req.Kind.Kind, err))
+	}
+
+	if parent.Spec == nil {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	patch := getSpecDefaultsPatch(parent.Spec)
+	if len(patch) == 0 {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return denyAdmission(fmt.Errorf("Failed to generate patch: %v", err))
+	}
+
+	patchType := admissionv1beta1.PatchTypeJSONPatch
+	return &admissionv1beta1.AdmissionResponse{
+		Allowed:   true,
+		Patch:     data,
+		PatchType: &patchType,
+	}
+}
+
+func denyAdmission(err error) *admissionv1beta1.AdmissionResponse {
+	return &admissionv1beta1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Reason:  metav1.StatusReasonInvalid,
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+// getBackend returns the backend from the spec.
+// The backendBucket and backendPrefix fields (or the defaults) are used as the shorthand for the GCS backend.
+func getBackend(parent *tfv1.Terraform) tfv1.TerraformBackend {
+	backend := tfv1.TerraformBackend{
+		Type:   tfv1.BackendGCS,
+		Config: make(map[string]string, 0),
+	}
+
+	if parent.Spec.Backend != nil {
+		backend.Type = parent.Spec.Backend.Type
+		for k, v := range parent.Spec.Backend.Config {
+			backend.Config[k] = v
+		}
+	}
+
+	if backend.Type == tfv1.BackendGCS {
+		backendBucket, backendPrefix := getBackendBucketandPrefix(parent)
+		if _, ok := backend.Config["bucket"]; ok == false {
+			backend.Config["bucket"] = backendBucket
+		}
+		if _, ok := backend.Config["prefix"]; ok == false {
+			backend.Config["prefix"] = backendPrefix
+		}
+	}
+
+	return backend
+}
+
+// makeBackendConfig renders the terraform block containing the backend configuration.
+func makeBackendConfig(backend tfv1.TerraformBackend) string {
+	keys := make([]string, 0)
+	for k := range backend.Config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := []string{
+		"terraform {",
+		fmt.Sprintf("  backend %s {", strconv.Quote(string(backend.Type))),
+	}
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("    %s = %s", k, strconv.Quote(backend.Config[k])))
+	}
+	lines = append(lines, "  }", "}")
+
+	return strings.Join(lines, "\n")
+}
+
+// makeStateFilePath returns the location of the state for the workspace, following the naming convention of each backend.
+func makeStateFilePath(backend tfv1.TerraformBackend, workspace string) string {
+	c := backend.Config
+	switch backend.Type {
+	case tfv1.BackendGCS:
+		return fmt.Sprintf("gs://%s/%s/%s.tfstate", c["bucket"], c["prefix"], workspace)
+	case tfv1.BackendS3:
+		prefix := c["workspace_key_prefix"]
+		if prefix == "" {
+			prefix = "env:"
+		}
+		return fmt.Sprintf("s3://%s/%s", c["bucket"], path.Join(prefix, workspace, c["key"]))
+	case tfv1.BackendAzureRM:
+		return fmt.Sprintf("https://%s.blob.core.windows.net/%s/%senv:%s", c["storage_account_name"], c["container_name"], c["key"], workspace)
+	case tfv1.BackendKubernetes:
+		namespace := c["namespace"]
+		if namespace == "" {
+			namespace = "default"
+		}
+		return fmt.Sprintf("kubernetes://%s/secrets/tfstate-%s-%s", namespace, workspace, c["secret_suffix"])
+	case tfv1.BackendHTTP:
+		return c["address"]
+	case tfv1.BackendLocal:
+		workspaceDir := c["workspace_dir"]
+		if workspaceDir == "" {
+			workspaceDir = "terraform.tfstate.d"
+		}
+		return path.Join(workspaceDir, workspace, "terraform.tfstate")
+	}
+	return ""
+}
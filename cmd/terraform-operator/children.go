@@ -1,17 +1,22 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	tfv1 "github.com/danisla/terraform-operator/pkg/types"
 	"github.com/jinzhu/copier"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 )
 
 // Name of the containers in the Terraform Pod
@@ -20,6 +25,9 @@ const (
 	GCS_TARBALL_CONTAINER_NAME = "gcs-tarball"
 )
 
+// TERMINATION_MESSAGE_LINES is the number of lines of the terraform error output shown in the condition message.
+const TERMINATION_MESSAGE_LINES = 20
+
 // TFPod contains the data needed to create the Terraform Pod
 type TFPod struct {
 	Image              string
@@ -29,13 +37,75 @@ type TFPod struct {
 	Workspace          string
 	SourceData         TerraformConfigSourceData
 	ProviderConfigKeys ProviderConfigKeys
+	Backend            tfv1.TerraformBackend
 	BackendBucket      string
 	BackendPrefix      string
 	TFParent           string
 	TFPlan             string
+	TFPlanHash         string
 	TFInputs           TerraformInputVars
 	TFVarsFrom         TerraformInputVars
 	TFVars             TerraformInputVars
+	PodTemplate        *corev1.PodTemplateSpec
+	Timeout            time.Duration
+}
+
+// makeTFPod returns the Terraform Pod data from the parent spec and the data resolved by the conditions.
+func makeTFPod(parent *tfv1.Terraform, providerConfigKeys *ProviderConfigKeys, sourceData *TerraformConfigSourceData, tfInputVars *TerraformInputVars, tfVarsFrom *TerraformInputVars, tfplanfile string) TFPod {
+	// Get the image and pull policy (or default) from the spec.
+	image, imagePullPolicy := getImageAndPullPolicy(parent)
+
+	// Get the backend bucket and backend prefix (or default) from the spec.
+	backendBucket, backendPrefix := getBackendBucketandPrefix(parent)
+
+	// Get the backend (or the GCS default) from the spec.
+	backend := getBackend(parent)
+
+	// Convert spec TFVars to TerraformInputVars
+	tfVars := make(TerraformInputVars, 0)
+	if parent.Spec.TFVars != nil {
+		for _, v := range *parent.Spec.TFVars {
+			tfVars[v.Name] = v.Value
+		}
+	}
+
+	return TFPod{
+		Image:              image,
+		ImagePullPolicy:    imagePullPolicy,
+		Namespace:          parent.GetNamespace(),
+		ProjectID:          config.Project,
+		Workspace:          makeWorkspaceName(parent, backend),
+		SourceData:         *sourceData,
+		ProviderConfigKeys: *providerConfigKeys,
+		Backend:            backend,
+		BackendBucket:      backendBucket,
+		BackendPrefix:      backendPrefix,
+		TFParent:           parent.GetName(),
+		TFPlan:             tfplanfile,
+		TFInputs:           *tfInputVars,
+		TFVarsFrom:         *tfVarsFrom,
+		TFVars:             tfVars,
+		PodTemplate:        parent.Spec.PodTemplate,
+		Timeout:            getPodTimeout(parent),
+	}
+}
+
+// getPodTimeout returns the run timeout from the spec or the driver config, zero means no timeout.
+func getPodTimeout(parent *tfv1.Terraform) time.Duration {
+	if parent.Spec.Timeout != "" {
+		// Timeout was validated with the spec.
+		timeout, _ := time.ParseDuration(parent.Spec.Timeout)
+		return timeout
+	}
+	return tfDriverConfig.PodTimeout
+}
+
+// makeWorkspaceName returns the workspace of the parent, backends without workspace support use the default workspace.
+func makeWorkspaceName(parent *tfv1.Terraform, backend tfv1.TerraformBackend) string {
+	if !backend.SupportsWorkspaces() {
+		return ""
+	}
+	return fmt.Sprintf("%s-%s", parent.GetNamespace(), parent.GetName())
 }
 
 func (tfp *TFPod) makeTerraformPod(podName, namespace string, kind tfv1.TFKind, currPod *corev1.Pod) (Pod, error) {
@@ -88,18 +158,47 @@ func (tfp *TFPod) makeTerraformPod(podName, namespace string, kind tfv1.TFKind,
 
 		Containers: []corev1.Container{
 			corev1.Container{
-				Name:            TERRAFORM_CONTAINER_NAME,
-				Image:           tfp.Image,
-				Command:         strings.Split(podCmd, " "),
-				ImagePullPolicy: tfp.ImagePullPolicy,
-				Env:             envVars,
-				VolumeMounts:    volumeMounts,
+				Name:                     TERRAFORM_CONTAINER_NAME,
+				Image:                    tfp.Image,
+				Command:                  strings.Split(podCmd, " "),
+				ImagePullPolicy:          tfp.ImagePullPolicy,
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				Env:                      envVars,
+				VolumeMounts:             volumeMounts,
 			},
 		},
 		Volumes: volumes,
 	}
 
+	if tfp.Timeout > 0 {
+		// The kubelet kills the pod after the timeout and the pod fails with the DeadlineExceeded reason.
+		deadline := int64(tfp.Timeout.Seconds())
+		podSpec.ActiveDeadlineSeconds = &deadline
+	}
+
+	if tfp.PodTemplate != nil {
+		var err error
+		podSpec, err = mergePodTemplate(podSpec, tfp.PodTemplate.Spec)
+		if err != nil {
+			return pod, err
+		}
+
+		// The generated labels select the terraform pods and take precedence.
+		for k, v := range tfp.PodTemplate.GetLabels() {
+			if _, ok := labels[k]; !ok {
+				labels[k] = v
+			}
+		}
+		for k, v := range tfp.PodTemplate.GetAnnotations() {
+			if _, ok := annotations[k]; !ok {
+				annotations[k] = v
+			}
+		}
+	}
+
 	if currPod != nil {
+		// The spec of an existing pod is immutable and already has the podTemplate it was created with.
+		// A change to the podTemplate changes the spec signature and starts a new pod.
 		copier.Copy(&podSpec, currPod.Spec)
 	}
 
@@ -115,6 +214,91 @@ func (tfp *TFPod) makeTerraformPod(podName, namespace string, kind tfv1.TFKind,
 	return pod, nil
 }
 
+// makeTerraformJob returns a Job with the same pod spec as the Terraform Pod.
+func (tfp *TFPod) makeTerraformJob(jobName, namespace string, kind tfv1.TFKind, job *tfv1.TerraformJob) (batchv1.Job, error) {
+	pod, err := tfp.makeTerraformPod(jobName, namespace, kind, nil)
+	if err != nil {
+		return batchv1.Job{}, err
+	}
+
+	return batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   namespace,
+			Labels:      pod.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            job.BackoffLimit,
+			ActiveDeadlineSeconds:   job.ActiveDeadlineSeconds,
+			TTLSecondsAfterFinished: job.TTLSecondsAfterFinished,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      pod.Labels,
+					Annotations: pod.Annotations,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}, nil
+}
+
+// mergePodTemplate applies the podTemplate spec as a strategic merge patch over the generated pod spec.
+// Containers are merged by name so the terraform container can be customized and sidecars can be added.
+func mergePodTemplate(podSpec corev1.PodSpec, templateSpec corev1.PodSpec) (corev1.PodSpec, error) {
+	var merged corev1.PodSpec
+
+	original, err := json.Marshal(podSpec)
+	if err != nil {
+		return merged, err
+	}
+
+	patchData, err := json.Marshal(templateSpec)
+	if err != nil {
+		return merged, err
+	}
+
+	// Fields not set in the template are marshaled as null and would delete the generated values.
+	var patch map[string]interface{}
+	if err := json.Unmarshal(patchData, &patch); err != nil {
+		return merged, err
+	}
+	removeNullFields(patch)
+	patchData, err = json.Marshal(patch)
+	if err != nil {
+		return merged, err
+	}
+
+	data, err := strategicpatch.StrategicMergePatch(original, patchData, corev1.PodSpec{})
+	if err != nil {
+		return merged, fmt.Errorf("Failed to merge podTemplate: %v", err)
+	}
+
+	err = json.Unmarshal(data, &merged)
+	return merged, err
+}
+
+func removeNullFields(obj map[string]interface{}) {
+	for k, v := range obj {
+		switch t := v.(type) {
+		case nil:
+			delete(obj, k)
+		case map[string]interface{}:
+			removeNullFields(t)
+		case []interface{}:
+			for _, item := range t {
+				if m, ok := item.(map[string]interface{}); ok {
+					removeNullFields(m)
+				}
+			}
+		}
+	}
+}
+
 func (tfp *TFPod) makeInitContainers() []corev1.Container {
 	initContainers := make([]corev1.Container, 0)
 
@@ -129,11 +313,12 @@ func (tfp *TFPod) makeInitContainers() []corev1.Container {
 		})
 
 		initContainers = append(initContainers, corev1.Container{
-			Name:            GCS_TARBALL_CONTAINER_NAME,
-			Image:           tfp.Image,
-			Command:         strings.Split(tfDriverConfig.PodCmdGCSTarball, " "),
-			ImagePullPolicy: tfp.ImagePullPolicy,
-			Env:             envVars,
+			Name:                     GCS_TARBALL_CONTAINER_NAME,
+			Image:                    tfp.Image,
+			Command:                  strings.Split(tfDriverConfig.PodCmdGCSTarball, " "),
+			ImagePullPolicy:          tfp.ImagePullPolicy,
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			Env:                      envVars,
 			VolumeMounts: []corev1.VolumeMount{
 				corev1.VolumeMount{
 					Name:      "state",
@@ -208,6 +393,16 @@ func (tfp *TFPod) makeEnvVars(podName string) []corev1.EnvVar {
 	envVars = append(envVars, tfp.makeProviderEnv()...)
 
 	// Terraform remote backend
+	envVars = append(envVars, corev1.EnvVar{
+		Name:  "BACKEND_TYPE",
+		Value: string(tfp.Backend.Type),
+	})
+	envVars = append(envVars, corev1.EnvVar{
+		Name:  "BACKEND_CONFIG",
+		Value: makeBackendConfig(tfp.Backend),
+	})
+
+	// GCS bucket and prefix where plan files are stored.
 	envVars = append(envVars, corev1.EnvVar{
 		Name:  "BACKEND_BUCKET",
 		Value: tfp.BackendBucket,
@@ -272,6 +467,14 @@ func (tfp *TFPod) makeEnvVars(podName string) []corev1.EnvVar {
 		})
 	}
 
+	// The hash of the downloaded plan is verified before it is applied.
+	if tfp.TFPlanHash != "" {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "TFPLAN_HASH",
+			Value: tfp.TFPlanHash,
+		})
+	}
+
 	return envVars
 }
 
@@ -331,7 +534,7 @@ func (tfp *TFPod) makeVolumeMounts() []corev1.VolumeMount {
 func (tfp *TFPod) makeLabels() map[string]string {
 	labels := make(map[string]string, 0)
 
-	labels["terraform-parent"] = tfp.TFParent
+	labels[parentLabel] = tfp.TFParent
 
 	return labels
 }
@@ -373,6 +576,91 @@ func makeOrdinalPodName(parent *tfv1.Terraform, index int) string {
 	return fmt.Sprintf("%s-%s-%d", parent.GetName(), parent.GetTFKindShort(), index)
 }
 
+func makeDriftPodName(parent *tfv1.Terraform, index int) string {
+	// Expected format is PARENT_NAME-PARENT_TYPE-drift-INDEX
+	return fmt.Sprintf("%s-%s-drift-%d", parent.GetName(), parent.GetTFKindShort(), index)
+}
+
+// setRunStatus records the spec signature, generation and sources used by the run being started.
+func setRunStatus(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, tfp *TFPod) {
+	status.RunSpecSig = parent.GetSig()
+	status.RunGeneration = parent.GetGeneration()
+
+	// Record the plan applied by the run, a different approved plan starts a new run.
+	status.RunPlanFile = tfp.TFPlan
+	status.RunPlanHash = tfp.TFPlanHash
+
+	// Keep the hash of the previous run for sources that changed.
+	previousHashes := make(map[string]string, 0)
+	for _, v := range status.Sources.ConfigMapHashes {
+		previousHashes[v.Name] = v.Hash
+	}
+
+	names := make([]string, 0)
+	for k := range tfp.SourceData.ConfigMapHashes {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	status.Sources.ConfigMapHashes = make([]tfv1.ConfigMapHash, 0)
+	for _, k := range names {
+		v := tfp.SourceData.ConfigMapHashes[k]
+		if prev, ok := previousHashes[k]; ok && prev != v.Hash {
+			v.PreviousHash = prev
+		}
+		status.Sources.ConfigMapHashes = append(status.Sources.ConfigMapHashes, v)
+	}
+	status.Sources.EmbeddedConfigMaps = make(tfv1.EmbeddedConfigMaps, 0)
+	for _, k := range tfp.SourceData.EmbeddedConfigMaps {
+		status.Sources.EmbeddedConfigMaps = append(status.Sources.EmbeddedConfigMaps, k)
+	}
+}
+
+// startNextRun creates the next ordinal pod, or Job when the spec.job is set, to run the terraform operation again, resetting the retry state.
+func startNextRun(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}, tfp *TFPod, reason string) error {
+	if parent.Spec.Job != nil {
+		jobName := makeOrdinalPodName(parent, getNextJobIndex(children.Jobs, status))
+
+		job, err := tfp.makeTerraformJob(jobName, parent.GetNamespace(), parent.GetTFKind(), parent.Spec.Job)
+		if err != nil {
+			return err
+		}
+		children.claimChildAndGetCurrent(job, desiredChildren)
+		parent.Log("INFO", "Creating Job/%s: %s", jobName, reason)
+		recordEvent(parent, corev1.EventTypeNormal, EventReasonStarted, "Created Job/%s%s", jobName, formatEventRunReason(reason))
+
+		status.Job = &tfv1.TerraformJobStatus{Name: jobName}
+		status.PodName = ""
+	} else {
+		index := 0
+		if len(children.Pods) > 0 {
+			index = getLastPodIndex(children.Pods) + 1
+		}
+		podName := makeOrdinalPodName(parent, index)
+
+		pod, err := tfp.makeTerraformPod(podName, parent.GetNamespace(), parent.GetTFKind(), nil)
+		if err != nil {
+			return err
+		}
+		children.claimChildAndGetCurrent(pod, desiredChildren)
+		parent.Log("INFO", "Creating Pod/%s: %s", podName, reason)
+		recordEvent(parent, corev1.EventTypeNormal, EventReasonStarted, "Created Pod/%s%s", podName, formatEventRunReason(reason))
+
+		status.PodName = podName
+	}
+
+	setRunStatus(parent, status, tfp)
+	status.RunReason = reason
+	status.PodStatus = tfv1.PodStatusRunning
+	status.StartedAt = ""
+	status.FinishedAt = ""
+	status.Duration = ""
+	status.RetryCount = 0
+	status.RetryNextAt = ""
+
+	return nil
+}
+
 func makeTerraformSourceConfigMap(name string, data string, filename string) corev1.ConfigMap {
 	cmData := strings.TrimSpace(data)
 
@@ -406,21 +694,28 @@ func getBackendBucketandPrefix(parent *tfv1.Terraform) (string, string) {
 	return backendBucket, backendPrefix
 }
 
-func makeStateFilePath(backendBucket, backendPrefix, workspace string) string {
-	return fmt.Sprintf("gs://%s/%s/%s.tfstate", backendBucket, backendPrefix, workspace)
-}
-
-func makeOutputVarsSecret(name string, namespace string, vars []tfv1.TerraformOutputVar) corev1.Secret {
+// makeOutputVarsSecret returns the Secret with the output values, the redacted sensitive vars of a status are skipped.
+// The salt of the sensitive output hashes is stored in an annotation when set.
+// The label selects the Secret for the outputs Secret informer.
+func makeOutputVarsSecret(name string, namespace string, vars []tfv1.TerraformOutputVar, salt string) corev1.Secret {
 	var secret corev1.Secret
 
 	data := make(map[string][]byte, 0)
 
 	if vars != nil {
 		for _, v := range vars {
+			if v.Sensitive && v.Hash != "" {
+				continue
+			}
 			data[v.Name] = []byte(v.Value)
 		}
 	}
 
+	annotations := make(map[string]string, 0)
+	if salt != "" {
+		annotations[tfv1.AnnotationOutputsHashSalt] = salt
+	}
+
 	secret = corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
@@ -429,7 +724,8 @@ func makeOutputVarsSecret(name string, namespace string, vars []tfv1.TerraformOu
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
 			Namespace:   namespace,
-			Annotations: map[string]string{},
+			Labels:      map[string]string{outputsSecretLabel: "true"},
+			Annotations: annotations,
 		},
 		Data: data,
 	}
@@ -448,6 +744,23 @@ func getLastPodIndex(pods map[string]corev1.Pod) int {
 	return index
 }
 
+// getNextJobIndex returns the ordinal index of the next Job.
+// The Job of the last run is taken from the status because it may have been removed after its ttlSecondsAfterFinished.
+func getNextJobIndex(jobs map[string]batchv1.Job, status *tfv1.TerraformOperatorStatus) int {
+	index := -1
+	for name := range jobs {
+		if jobIndex := getOrdinalIndex(name); jobIndex > index {
+			index = jobIndex
+		}
+	}
+	if status.Job != nil && status.Job.Name != "" {
+		if jobIndex := getOrdinalIndex(status.Job.Name); jobIndex > index {
+			index = jobIndex
+		}
+	}
+	return index + 1
+}
+
 func getPodStatus(pods map[string]corev1.Pod) (int, int, int, int) {
 	active := 0
 	succeeded := 0
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestMergePodTemplate(t *testing.T) {
+	podSpec := corev1.PodSpec{
+		ServiceAccountName: "terraform",
+		RestartPolicy:      corev1.RestartPolicyNever,
+		Containers: []corev1.Container{
+			{
+				Name:  TERRAFORM_CONTAINER_NAME,
+				Image: "gcr.io/cloud-solutions-group/terraform-pod:latest",
+				Env:   []corev1.EnvVar{{Name: "TF_VAR_foo", Value: "bar"}},
+			},
+		},
+		Volumes: []corev1.Volume{{Name: "gcs-tarball"}},
+	}
+
+	limits := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+
+	templateSpec := corev1.PodSpec{
+		NodeSelector: map[string]string{"pool": "terraform"},
+		Containers: []corev1.Container{
+			{
+				Name:         TERRAFORM_CONTAINER_NAME,
+				Resources:    corev1.ResourceRequirements{Limits: limits},
+				VolumeMounts: []corev1.VolumeMount{{Name: "ca-certs", MountPath: "/etc/ssl/certs"}},
+			},
+		},
+		Volumes: []corev1.Volume{{Name: "ca-certs"}},
+	}
+
+	got, err := mergePodTemplate(podSpec, templateSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := podSpec.DeepCopy()
+	exp.NodeSelector = map[string]string{"pool": "terraform"}
+	exp.Containers[0].Resources = corev1.ResourceRequirements{Limits: limits}
+	exp.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "ca-certs", MountPath: "/etc/ssl/certs"}}
+	exp.Volumes = []corev1.Volume{{Name: "ca-certs"}, {Name: "gcs-tarball"}}
+
+	if !reflect.DeepEqual(got.Containers[0].Env, exp.Containers[0].Env) || got.ServiceAccountName != exp.ServiceAccountName || got.RestartPolicy != exp.RestartPolicy {
+		t.Errorf("generated fields were not kept\n\texp: %#v\n\n\tgot: %#v", *exp, got)
+	}
+	if !reflect.DeepEqual(got.NodeSelector, exp.NodeSelector) || !reflect.DeepEqual(got.Containers[0].VolumeMounts, exp.Containers[0].VolumeMounts) {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", *exp, got)
+	}
+	if got.Containers[0].Resources.Limits.Cpu().Cmp(resource.MustParse("1")) != 0 {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", exp.Containers[0].Resources, got.Containers[0].Resources)
+	}
+	if len(got.Volumes) != 2 {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", exp.Volumes, got.Volumes)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+func reconcileApproved(condition *tfv1.Condition, parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}) tfv1.ConditionStatus {
+	newStatus := tfv1.ConditionFalse
+
+	// The plan file and hash were set in the status from the tfplan by the TFPlanReady condition.
+	planFile := status.TFPlan
+	planHash := status.TFPlanHash
+
+	if planHash == "" {
+		condition.Reason = fmt.Sprintf("%s/%s: Waiting for plan hash", tfv1.TFKindPlan, parent.Spec.TFPlan)
+		return newStatus
+	}
+
+	// Approval from the spec takes precedence over the annotations.
+	approvedFile := parent.GetAnnotations()[tfv1.AnnotationApprovedPlanFile]
+	approvedHash := parent.GetAnnotations()[tfv1.AnnotationApprovedPlanHash]
+	if parent.Spec.Approval.PlanFile != "" || parent.Spec.Approval.PlanHash != "" {
+		approvedFile = parent.Spec.Approval.PlanFile
+		approvedHash = parent.Spec.Approval.PlanHash
+	}
+
+	if approvedFile == "" && approvedHash == "" {
+		condition.Reason = fmt.Sprintf("Waiting for approval of plan: %s", planFile)
+		condition.Message = fmt.Sprintf("Approve the plan by setting the %s=%s and %s=%s annotations", tfv1.AnnotationApprovedPlanFile, planFile, tfv1.AnnotationApprovedPlanHash, planHash)
+		return newStatus
+	}
+
+	if approvedFile != planFile || approvedHash != planHash {
+		// The referenced plan changed since it was approved.
+		condition.Reason = fmt.Sprintf("Approval does not match current plan: %s", planFile)
+		condition.Message = fmt.Sprintf("Approved plan %s (hash %s), current plan %s (hash %s)", approvedFile, approvedHash, planFile, planHash)
+		return newStatus
+	}
+
+	newStatus = tfv1.ConditionTrue
+	condition.Reason = fmt.Sprintf("Plan approved: %s", planFile)
+
+	return newStatus
+}
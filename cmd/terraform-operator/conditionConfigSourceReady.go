@@ -91,8 +91,8 @@ func reconcileConfigSourceReady(condition *tfv1.Condition, parent *tfv1.Terrafor
 		if source.TFApply != "" || source.TFPlan != "" {
 			var tf *tfv1.Terraform
 
-			tfapply, tfapplyErr := getTerraform("tfapply", parent.GetNamespace(), source.TFApply)
-			tfplan, tfplanErr := getTerraform("tfplan", parent.GetNamespace(), source.TFPlan)
+			tfapply, tfapplyErr := getTerraform(tfv1.TFKindApply, parent.GetNamespace(), source.TFApply)
+			tfplan, tfplanErr := getTerraform(tfv1.TFKindPlan, parent.GetNamespace(), source.TFPlan)
 
 			if source.TFApply != "" && source.TFPlan != "" && tfapplyErr != nil && tfplanErr != nil {
 				// no source available yet.
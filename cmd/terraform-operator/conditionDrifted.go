@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func reconcileDrifted(condition *tfv1.Condition, parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}, providerConfigKeys *ProviderConfigKeys, sourceData *TerraformConfigSourceData, tfInputVars *TerraformInputVars, tfVarsFrom *TerraformInputVars) tfv1.ConditionStatus {
+	newStatus := tfv1.ConditionFalse
+
+	// The spec is verified before the conditions are reconciled, check again to never run a drift plan on every resync.
+	if err := parent.Spec.DriftDetection.Verify(); err != nil {
+		condition.Reason = err.Error()
+		return condition.Status
+	}
+	interval, _ := parent.Spec.DriftDetection.GetInterval()
+
+	if status.Drift == nil {
+		status.Drift = &tfv1.TerraformDriftStatus{}
+	}
+	drift := status.Drift
+
+	// Drift pods run a plan of the current config in the same workspace.
+	tfp := makeTFPod(parent, providerConfigKeys, sourceData, tfInputVars, tfVarsFrom, "")
+
+	// Claim the current drift pod, previous drift pods are removed.
+	if drift.PodName != "" {
+		currPod, found := children.DriftPods[drift.PodName]
+		if found || drift.PodStatus == tfv1.PodStatusRunning {
+			var podPtr *corev1.Pod
+			if found {
+				podPtr = &currPod
+			}
+			pod, err := tfp.makeTerraformPod(drift.PodName, parent.GetNamespace(), tfv1.TFKindPlan, podPtr)
+			if err != nil {
+				condition.Reason = fmt.Sprintf("Pod/%s: Failed to create pod: %v", drift.PodName, err)
+				return condition.Status
+			}
+			children.claimChildAndGetCurrent(pod, desiredChildren)
+		}
+
+		if found {
+			switch currPod.Status.Phase {
+			case corev1.PodSucceeded:
+				if drift.PodStatus != tfv1.PodStatusPassed {
+					plan := currPod.Annotations["terraform-plan"]
+					summary, err := parseTerraformPlan(plan)
+					if err != nil {
+						parent.Log("ERROR", "Failed to parse drift plan: %s, %v", plan, err)
+						condition.Reason = "Internal error"
+						return condition.Status
+					}
+					drift.PodStatus = tfv1.PodStatusPassed
+					drift.LastCheckedAt = time.Now().Format(time.RFC3339)
+					drift.PlanDiff = &summary
+					drift.Drifted = summary.Added+summary.Changed+summary.Destroyed+summary.Replaced > 0
+
+					if drift.Drifted && parent.Spec.DriftDetection.AutoApply {
+						if err := startNextRun(parent, status, children, desiredChildren, &tfp, fmt.Sprintf("Drift detected by Pod/%s", drift.PodName)); err != nil {
+							condition.Reason = fmt.Sprintf("Failed to create pod: %v", err)
+							return condition.Status
+						}
+					}
+				}
+			case corev1.PodFailed:
+				if drift.PodStatus != tfv1.PodStatusFailed {
+					drift.PodStatus = tfv1.PodStatusFailed
+					drift.LastCheckedAt = time.Now().Format(time.RFC3339)
+				}
+			default:
+				drift.PodStatus = tfv1.PodStatusRunning
+			}
+		}
+	}
+
+	if drift.PodStatus == tfv1.PodStatusRunning {
+		condition.Reason = fmt.Sprintf("Pod/%s: RUNNING", drift.PodName)
+		return condition.Status
+	}
+
+	// An apply that finished after the last check has converged the drift.
+	lastApplied, _ := time.Parse(time.RFC3339, status.FinishedAt)
+	lastChecked, _ := time.Parse(time.RFC3339, drift.LastCheckedAt)
+	if drift.Drifted && lastApplied.After(lastChecked) {
+		drift.Drifted = false
+	}
+
+	// Schedule the next check from the last apply or the last check, whichever is later.
+	nextCheck := lastApplied
+	if lastChecked.After(nextCheck) {
+		nextCheck = lastChecked
+	}
+	nextCheck = nextCheck.Add(interval)
+
+	if status.PodStatus == tfv1.PodStatusPassed && !time.Now().Before(nextCheck) {
+		index := 0
+		if drift.PodName != "" {
+			index = getOrdinalIndex(drift.PodName) + 1
+		}
+		podName := makeDriftPodName(parent, index)
+		pod, err := tfp.makeTerraformPod(podName, parent.GetNamespace(), tfv1.TFKindPlan, nil)
+		if err != nil {
+			condition.Reason = fmt.Sprintf("Pod/%s: Failed to create pod: %v", podName, err)
+			return condition.Status
+		}
+		children.claimChildAndGetCurrent(pod, desiredChildren)
+		parent.Log("INFO", "Creating drift detection Pod/%s", podName)
+
+		drift.PodName = podName
+		drift.PodStatus = tfv1.PodStatusRunning
+		drift.NextCheckAt = ""
+		condition.Reason = fmt.Sprintf("Pod/%s: RUNNING", podName)
+		return condition.Status
+	}
+	drift.NextCheckAt = nextCheck.Format(time.RFC3339)
+
+	switch {
+	case drift.Drifted:
+		newStatus = tfv1.ConditionTrue
+		d := drift.PlanDiff
+		condition.Reason = fmt.Sprintf("Drift detected: %d to add, %d to change, %d to destroy, %d to replace", d.Added, d.Changed, d.Destroyed, d.Replaced)
+		if parent.Spec.DriftDetection.AutoApply {
+			condition.Message = fmt.Sprintf("Re-applying with Pod/%s", status.PodName)
+		}
+	case drift.PodStatus == tfv1.PodStatusFailed:
+		condition.Reason = fmt.Sprintf("Pod/%s: FAILED, next check at %s", drift.PodName, drift.NextCheckAt)
+	case drift.LastCheckedAt != "":
+		condition.Reason = fmt.Sprintf("No drift detected, next check at %s", drift.NextCheckAt)
+	default:
+		condition.Reason = fmt.Sprintf("Next check at %s", drift.NextCheckAt)
+	}
+
+	return newStatus
+}
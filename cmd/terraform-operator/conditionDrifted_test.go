@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestReconcileDriftedInvalidInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval string
+		expected string
+	}{
+		{"invalid", "hourly", "Invalid 'spec.driftDetection.interval': time: invalid duration \"hourly\""},
+		{"zero", "0s", "'spec.driftDetection.interval' must be at least 1m0s"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := makeTestTerraform(tfv1.TFKindApply, "test")
+			parent.Spec.DriftDetection = &tfv1.TerraformDriftDetection{Interval: tc.interval}
+
+			status := tfv1.TerraformOperatorStatus{PodStatus: tfv1.PodStatusPassed}
+			condition := &tfv1.Condition{Type: tfv1.ConditionDrifted, Status: tfv1.ConditionFalse}
+			children := TerraformChildren{DriftPods: make(map[string]corev1.Pod, 0)}
+			desiredChildren := make([]interface{}, 0)
+
+			reconcileDrifted(condition, &parent, &status, &children, &desiredChildren, nil, nil, nil, nil)
+
+			if condition.Reason != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, condition.Reason)
+			}
+			if len(desiredChildren) != 0 || status.Drift != nil {
+				t.Errorf("expected no drift pod, got: %#v", desiredChildren)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+func reconcilePolicyPassed(condition *tfv1.Condition, parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}) tfv1.ConditionStatus {
+	newStatus := tfv1.ConditionFalse
+
+	// The plan diff was set in the status from the tfplan by the TFPlanReady condition.
+	if status.TFPlanDiff == nil {
+		condition.Reason = fmt.Sprintf("%s/%s: Waiting for plan diff", tfv1.TFKindPlan, parent.Spec.TFPlan)
+		return newStatus
+	}
+
+	allFound := true
+	reasons := make([]string, 0)
+	violations := make([]string, 0)
+
+	for _, source := range parent.Spec.Policies {
+		configMapData, err := getConfigMapSourceData(parent.GetNamespace(), source.ConfigMap)
+		if err != nil {
+			allFound = false
+			reasons = append(reasons, fmt.Sprintf("ConfigMap/%s: WAITING", source.ConfigMap))
+			continue
+		}
+
+		keys := make([]string, 0)
+		for k := range configMapData {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			policy, err := parsePlanPolicy(configMapData[k])
+			if err != nil {
+				allFound = false
+				reasons = append(reasons, fmt.Sprintf("ConfigMap/%s/%s: INVALID: %v", source.ConfigMap, k, err))
+				continue
+			}
+			for _, v := range evaluatePlanPolicy(policy, status.TFPlanDiff) {
+				violations = append(violations, fmt.Sprintf("ConfigMap/%s/%s: %s", source.ConfigMap, k, v))
+			}
+		}
+	}
+
+	if !allFound {
+		condition.Reason = strings.Join(reasons, ",")
+		return newStatus
+	}
+
+	if len(violations) > 0 {
+		condition.Reason = fmt.Sprintf("Plan violates %d policy rule(s): %s", len(violations), status.TFPlan)
+		condition.Message = strings.Join(violations, "; ")
+		return newStatus
+	}
+
+	newStatus = tfv1.ConditionTrue
+	condition.Reason = fmt.Sprintf("Plan passed all policies: %s", status.TFPlan)
+
+	return newStatus
+}
@@ -22,23 +22,19 @@ func reconcileTFInputsReady(condition *tfv1.Condition, parent *tfv1.Terraform, s
 				reasons = append(reasons, fmt.Sprintf("%s/%s: WAITING", tfv1.TFKindApply, tfinput.Name))
 			} else {
 				varsFound := true
+				outputs, err := getTFApplyOutputs(tfapply)
+				if err != nil {
+					parent.Log("WARN", "%s/%s: Failed to read outputs: %v", tfv1.TFKindApply, tfinput.Name, err)
+				}
 				for _, srcVar := range tfinput.VarMap {
-					if tfapply.Status.TFOutput == nil || len(*tfapply.Status.TFOutput) == 0 {
+					if len(outputs) == 0 {
 						varsFound = false
 						reasons = append(reasons, fmt.Sprintf("%s/%s: Waiting for output vars", tfv1.TFKindApply, tfinput.Name))
+					} else if v, ok := outputs[srcVar.Source]; ok {
+						tfInputVars[srcVar.Dest] = v
 					} else {
-						found := false
-						for _, k := range *tfapply.Status.TFOutput {
-							if k.Name == srcVar.Source {
-								found = true
-								tfInputVars[srcVar.Dest] = k.Value
-								break
-							}
-						}
-						if !found {
-							varsFound = false
-							reasons = append(reasons, fmt.Sprintf("%s/%s: Output not found: %s", tfv1.TFKindApply, tfinput.Name, srcVar))
-						}
+						varsFound = false
+						reasons = append(reasons, fmt.Sprintf("%s/%s: Output not found: %s", tfv1.TFKindApply, tfinput.Name, srcVar))
 					}
 				}
 				if varsFound {
@@ -72,3 +68,50 @@ func reconcileTFInputsReady(condition *tfv1.Condition, parent *tfv1.Terraform, s
 
 	return newStatus, tfInputVars
 }
+
+// getTFApplyOutputs returns the output values of the TerraformApply.
+// Sensitive values are redacted in the status and are read from the outputs Secret, as are all values with spec.outputsSecretOnly.
+func getTFApplyOutputs(tfapply tfv1.Terraform) (map[string]string, error) {
+	var secretData map[string][]byte
+	if needsOutputsSecret(tfapply.Status) {
+		secret, err := tfInformers.GetOutputsSecret(tfapply.GetNamespace(), tfapply.Status.TFOutputSecret)
+		if err != nil {
+			return nil, err
+		}
+		secretData = secret.Data
+	}
+	return makeOutputValues(tfapply.Status.TFOutput, secretData), nil
+}
+
+// needsOutputsSecret returns true if some output values are only in the outputs Secret.
+func needsOutputsSecret(status tfv1.TerraformOperatorStatus) bool {
+	if status.TFOutputSecret == "" {
+		return false
+	}
+	if status.TFOutput == nil {
+		return true
+	}
+	for _, v := range *status.TFOutput {
+		if v.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// makeOutputValues returns the map of output values from the status outputs and the outputs Secret data.
+// The redacted sensitive values in the status are skipped, the Secret data takes precedence.
+func makeOutputValues(outputVars *[]tfv1.TerraformOutputVar, secretData map[string][]byte) map[string]string {
+	outputs := make(map[string]string, 0)
+	if outputVars != nil {
+		for _, v := range *outputVars {
+			if !v.Sensitive {
+				outputs[v.Name] = v.Value
+			}
+		}
+	}
+	for k, v := range secretData {
+		outputs[k] = string(v)
+	}
+	return outputs
+}
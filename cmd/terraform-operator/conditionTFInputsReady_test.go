@@ -0,0 +1,101 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestMakeOutputValues(t *testing.T) {
+	redacted := &[]tfv1.TerraformOutputVar{
+		{Name: "ip", Type: "string", Value: "10.0.0.1"},
+		{Name: "password", Sensitive: true, Type: "string", Hash: toSaltedSha256("abc123", "hunter2")},
+	}
+	secretData := map[string][]byte{"ip": []byte("10.0.0.1"), "password": []byte("hunter2")}
+
+	tests := []struct {
+		name        string
+		status      tfv1.TerraformOperatorStatus
+		secretData  map[string][]byte
+		needsSecret bool
+		expected    map[string]string
+	}{
+		{
+			"status only",
+			tfv1.TerraformOperatorStatus{TFOutput: &[]tfv1.TerraformOutputVar{{Name: "ip", Value: "10.0.0.1"}}, TFOutputSecret: "test-tfapply-outputs"},
+			nil,
+			false,
+			map[string]string{"ip": "10.0.0.1"},
+		},
+		{
+			"sensitive from secret",
+			tfv1.TerraformOperatorStatus{TFOutput: redacted, TFOutputSecret: "test-tfapply-outputs"},
+			secretData,
+			true,
+			map[string]string{"ip": "10.0.0.1", "password": "hunter2"},
+		},
+		{
+			"sensitive without secret",
+			tfv1.TerraformOperatorStatus{TFOutput: redacted},
+			nil,
+			false,
+			map[string]string{"ip": "10.0.0.1"},
+		},
+		{
+			"secret only",
+			tfv1.TerraformOperatorStatus{TFOutputSecret: "test-tfapply-outputs"},
+			secretData,
+			true,
+			map[string]string{"ip": "10.0.0.1", "password": "hunter2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := needsOutputsSecret(tc.status); got != tc.needsSecret {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.needsSecret, got)
+			}
+			if got := makeOutputValues(tc.status.TFOutput, tc.secretData); !reflect.DeepEqual(tc.expected, got) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetTFApplyOutputs(t *testing.T) {
+	defer func(informers *TerraformInformers) { tfInformers = informers }(tfInformers)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	indexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-tfapply-outputs"},
+		Data:       map[string][]byte{"ip": []byte("10.0.0.1"), "password": []byte("hunter2")},
+	})
+	tfInformers = &TerraformInformers{secretsLister: corelisters.NewSecretLister(indexer)}
+
+	tfapply := makeTestTerraform(tfv1.TFKindApply, "test")
+	tfapply.Status.TFOutputSecret = "test-tfapply-outputs"
+	tfapply.Status.TFOutput = &[]tfv1.TerraformOutputVar{
+		{Name: "ip", Type: "string", Value: "10.0.0.1"},
+		{Name: "password", Sensitive: true, Type: "string", Hash: toSaltedSha256("abc123", "hunter2")},
+	}
+
+	got, err := getTFApplyOutputs(tfapply)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{"ip": "10.0.0.1", "password": "hunter2"}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", expected, got)
+	}
+
+	tfapply.Status.TFOutputSecret = "missing-tfapply-outputs"
+	if _, err := getTFApplyOutputs(tfapply); err == nil {
+		t.Errorf("expected error for missing outputs Secret")
+	}
+}
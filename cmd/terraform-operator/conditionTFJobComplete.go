@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// reconcileTFJobComplete runs the terraform operation as a Job when the spec.job is set.
+// Retries are handled by the Job backoffLimit.
+func reconcileTFJobComplete(condition *tfv1.Condition, parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}, providerConfigKeys *ProviderConfigKeys, sourceData *TerraformConfigSourceData, tfInputVars *TerraformInputVars, tfVarsFrom *TerraformInputVars, tfplanfile string) tfv1.ConditionStatus {
+	newStatus := tfv1.ConditionFalse
+	reasons := make([]string, 0)
+
+	// The run status of the last sync, the Succeeded and Failed Events are only recorded when it changes.
+	prevPodStatus := status.PodStatus
+
+	// Terraform Pod data
+	tfp := makeTFPod(parent, providerConfigKeys, sourceData, tfInputVars, tfVarsFrom, tfplanfile)
+	if tfplanfile != "" {
+		// Hash of the plan from the TFPlanReady condition, matching the approved hash when approval is required.
+		tfp.TFPlanHash = status.TFPlanHash
+	}
+
+	status.Workspace = tfp.Workspace
+	status.StateFile = makeStateFilePath(tfp.Backend, tfp.Workspace)
+
+	if status.Job == nil || status.Job.Name == "" {
+		// New job
+		if err := startNextRun(parent, status, children, desiredChildren, &tfp, ""); err != nil {
+			condition.Reason = fmt.Sprintf("Failed to create job: %v", err)
+			return condition.Status
+		}
+		condition.Reason = fmt.Sprintf("Job/%s: CREATED", status.Job.Name)
+		return condition.Status
+	}
+
+	// Claim existing jobs, the pod template of a Job is immutable so the current jobs are not updated.
+	for jobName := range children.Jobs {
+		job, err := tfp.makeTerraformJob(jobName, parent.GetNamespace(), parent.GetTFKind(), parent.Spec.Job)
+		if err != nil {
+			condition.Reason = fmt.Sprintf("Job/%s: Failed to create job: %v", jobName, err)
+			return condition.Status
+		}
+		children.claimChildAndGetCurrent(job, desiredChildren)
+	}
+
+	jobName := status.Job.Name
+	currJob, found := children.Jobs[jobName]
+	if !found && !status.Job.IsFinished() {
+		// The job has not been observed yet.
+		job, err := tfp.makeTerraformJob(jobName, parent.GetNamespace(), parent.GetTFKind(), parent.Spec.Job)
+		if err != nil {
+			condition.Reason = fmt.Sprintf("Job/%s: Failed to create job: %v", jobName, err)
+			return condition.Status
+		}
+		children.claimChildAndGetCurrent(job, desiredChildren)
+		condition.Reason = fmt.Sprintf("Job/%s: PENDING", jobName)
+		return condition.Status
+	}
+
+	// Record the run of jobs created before the run status was tracked.
+	if status.RunSpecSig == "" {
+		setRunStatus(parent, status, &tfp)
+	}
+
+	// A finished job that is not found was removed after its ttlSecondsAfterFinished, the last observed status is kept.
+	annotationsRead := false
+	pendingReason := ""
+	failedMessage := ""
+	if found {
+		setJobStatus(status, currJob)
+
+		pods, err := getJobPods(parent.GetNamespace(), jobName)
+		if err != nil {
+			parent.Log("WARN", "Job/%s: Failed to list pods: %v", jobName, err)
+		}
+		failedMessage = getJobFailedMessage(pods)
+		for _, pod := range pods {
+			if reason := getPodPendingReason(pod); reason != "" {
+				pendingReason = fmt.Sprintf("Pod/%s: PENDING: %s", pod.GetName(), reason)
+			}
+			if pod.Status.Phase == corev1.PodSucceeded {
+				// Populate the plan and outputs from the pod annotations.
+				if err := setPodAnnotationStatus(parent, status, children, desiredChildren, pod); err != nil {
+					condition.Reason = "Internal error"
+					return condition.Status
+				}
+				status.PodName = pod.GetName()
+				annotationsRead = true
+				break
+			}
+			status.PodName = pod.GetName()
+		}
+	}
+
+	// Keep the outputs Secret after the job pods are removed.
+	// The sensitive values are not in the status, the data and salt of the current Secret are kept.
+	if !annotationsRead && status.TFOutputSecret != "" {
+		if curr, ok := children.Secrets[status.TFOutputSecret]; ok {
+			secret := makeOutputVarsSecret(status.TFOutputSecret, parent.GetNamespace(), nil, curr.Annotations[tfv1.AnnotationOutputsHashSalt])
+			secret.Data = curr.Data
+			children.claimChildAndGetCurrent(secret, desiredChildren)
+		} else if status.TFOutput != nil {
+			secret := makeOutputVarsSecret(status.TFOutputSecret, parent.GetNamespace(), *status.TFOutput, "")
+			children.claimChildAndGetCurrent(secret, desiredChildren)
+		}
+	}
+
+	switch {
+	case getJobCondition(status.Job, batchv1.JobComplete) != nil:
+		// Passed
+		status.PodStatus = tfv1.PodStatusPassed
+		newStatus = tfv1.ConditionTrue
+		reasons = append(reasons, fmt.Sprintf("Job/%s: COMPLETED", jobName))
+		if isPodStatusTransition(prevPodStatus) {
+			recordEvent(parent, corev1.EventTypeNormal, EventReasonSucceeded, "Job/%s completed in %s", jobName, status.Duration)
+		}
+
+	case getJobCondition(status.Job, batchv1.JobFailed) != nil:
+		// Failed
+		cond := getJobCondition(status.Job, batchv1.JobFailed)
+		status.PodStatus = tfv1.PodStatusFailed
+		reasons = append(reasons, fmt.Sprintf("Job/%s: %s: %s", jobName, cond.Reason, cond.Message))
+		if failedMessage != "" {
+			reasons = append(reasons, getMessageSummary(failedMessage))
+			condition.Message = getMessageTail(failedMessage, TERMINATION_MESSAGE_LINES)
+		}
+		if isPodStatusTransition(prevPodStatus) {
+			recordEvent(parent, corev1.EventTypeWarning, EventReasonFailed, "Job/%s failed: %s", jobName, cond.Reason)
+		}
+
+	default:
+		// Active
+		status.PodStatus = tfv1.PodStatusRunning
+		reasons = append(reasons, fmt.Sprintf("Job/%s: RUNNING", jobName))
+		if pendingReason != "" {
+			reasons = append(reasons, pendingReason)
+		}
+	}
+
+	if status.Job.IsFinished() {
+		// Start a new run when the spec or a source with trigger enabled changed since the last run was started.
+		if reason := getRerunReason(parent, status, sourceData); reason != "" {
+			if err := startNextRun(parent, status, children, desiredChildren, &tfp, reason); err != nil {
+				condition.Reason = fmt.Sprintf("Failed to create job: %v", err)
+				return condition.Status
+			}
+			condition.Reason = fmt.Sprintf("%s: Job/%s", reason, status.Job.Name)
+			return tfv1.ConditionFalse
+		}
+	}
+
+	// A failed Job is not retried after its backoffLimit, the schedule continues after both results.
+	if status.PodStatus == tfv1.PodStatusPassed || status.PodStatus == tfv1.PodStatusFailed {
+		// Start the next scheduled run, missed runs are skipped.
+		started, err := startScheduledRun(parent, status, children, desiredChildren, &tfp)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("Failed to start scheduled run: %v", err))
+		} else if started {
+			reasons = append(reasons, fmt.Sprintf("Scheduled run: Job/%s", status.Job.Name))
+			newStatus = tfv1.ConditionFalse
+		}
+	}
+
+	// Explain why the current run was started, unless the error output of a failed run is shown.
+	if status.RunReason != "" && condition.Message == "" {
+		condition.Message = fmt.Sprintf("Run started by: %s", status.RunReason)
+	}
+
+	condition.Reason = strings.Join(reasons, ",")
+
+	return newStatus
+}
+
+// setJobStatus copies the Job status to the status.job and sets the run times.
+func setJobStatus(status *tfv1.TerraformOperatorStatus, job batchv1.Job) {
+	status.Job.Active = job.Status.Active
+	status.Job.Succeeded = job.Status.Succeeded
+	status.Job.Failed = job.Status.Failed
+	status.Job.Conditions = job.Status.Conditions
+	status.RetryCount = job.Status.Failed
+	status.RetryNextAt = ""
+
+	if job.Status.StartTime == nil {
+		return
+	}
+	status.StartedAt = job.Status.StartTime.Format(time.RFC3339)
+
+	var finishedAt time.Time
+	if job.Status.CompletionTime != nil {
+		finishedAt = job.Status.CompletionTime.Time
+	} else if cond := getJobCondition(status.Job, batchv1.JobFailed); cond != nil {
+		finishedAt = cond.LastTransitionTime.Time
+	} else {
+		status.FinishedAt = ""
+		status.Duration = ""
+		return
+	}
+	status.FinishedAt = finishedAt.Format(time.RFC3339)
+
+	// Set Duration in seconds
+	duration := finishedAt.Sub(job.Status.StartTime.Time)
+	status.Duration = fmt.Sprintf("%02.0fm%02.0fs", duration.Minutes(), duration.Seconds())
+}
+
+// getJobCondition returns the Job condition of the given type if it is true, or nil.
+func getJobCondition(jobStatus *tfv1.TerraformJobStatus, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i, c := range jobStatus.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return &jobStatus.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// getJobFailedMessage returns the failed message of the terraform container of the most recently created failed pod of the Job.
+// The pods are listed in no particular order.
+func getJobFailedMessage(pods []corev1.Pod) string {
+	var newest *corev1.Pod
+	for i := range pods {
+		if pods[i].Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if newest == nil || newest.CreationTimestamp.Before(&pods[i].CreationTimestamp) {
+			newest = &pods[i]
+		}
+	}
+	if newest == nil {
+		return ""
+	}
+	for _, cStatus := range newest.Status.ContainerStatuses {
+		if cStatus.Name == TERRAFORM_CONTAINER_NAME {
+			return getFailedMessage(*newest, cStatus)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetNextJobIndex(t *testing.T) {
+	tests := []struct {
+		name     string
+		jobs     []string
+		lastJob  string
+		expected int
+	}{
+		{"first job", []string{}, "", 0},
+		{"existing jobs", []string{"test-tfapply-0", "test-tfapply-1"}, "test-tfapply-1", 2},
+		{"job removed after ttl", []string{}, "test-tfapply-3", 4},
+		{"job created but not observed", []string{"test-tfapply-0"}, "test-tfapply-1", 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jobs := make(map[string]batchv1.Job, 0)
+			for _, name := range tc.jobs {
+				jobs[name] = batchv1.Job{}
+			}
+			status := tfv1.TerraformOperatorStatus{}
+			if tc.lastJob != "" {
+				status.Job = &tfv1.TerraformJobStatus{Name: tc.lastJob}
+			}
+			got := getNextJobIndex(jobs, &status)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetJobFailedMessage(t *testing.T) {
+	created := time.Date(2018, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	makePod := func(name string, phase corev1.PodPhase, age time.Duration, message string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created.Add(-age))},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  TERRAFORM_CONTAINER_NAME,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+				}},
+			},
+		}
+	}
+
+	older := makePod("test-tfapply-0-abcde", corev1.PodFailed, 2*time.Minute, "Error: first attempt")
+	newer := makePod("test-tfapply-0-fghij", corev1.PodFailed, time.Minute, "Error: second attempt")
+	running := makePod("test-tfapply-0-klmno", corev1.PodRunning, 0, "")
+
+	tests := []struct {
+		name     string
+		pods     []corev1.Pod
+		expected string
+	}{
+		{"no pods", []corev1.Pod{}, ""},
+		{"newest listed last", []corev1.Pod{older, newer}, "Error: second attempt"},
+		{"newest listed first", []corev1.Pod{newer, older}, "Error: second attempt"},
+		{"running pod is newest", []corev1.Pod{running, older, newer}, "Error: second attempt"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := getJobFailedMessage(tc.pods)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -24,6 +24,11 @@ func reconcileTFPlanReady(condition *tfv1.Condition, parent *tfv1.Terraform, sta
 	if tfplanfile != "" {
 		newStatus = tfv1.ConditionTrue
 		condition.Reason = fmt.Sprintf("%s/%s: READY", tfv1.TFKindPlan, tfplan.Name)
+
+		// Show the plan being applied in the status.
+		status.TFPlan = tfplanfile
+		status.TFPlanHash = tfplan.Status.TFPlanHash
+		status.TFPlanDiff = tfplan.Status.TFPlanDiff
 	}
 
 	return newStatus, tfplanfile
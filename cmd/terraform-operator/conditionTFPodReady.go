@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -16,47 +17,19 @@ func reconcileTFPodReady(condition *tfv1.Condition, parent *tfv1.Terraform, stat
 	newStatus := tfv1.ConditionFalse
 	reasons := make([]string, 0)
 
-	// Get the image and pull policy (or default) from the spec.
-	image, imagePullPolicy := getImageAndPullPolicy(parent)
-
-	// Get the backend bucket and backend prefix (or default) from the spec.
-	backendBucket, backendPrefix := getBackendBucketandPrefix(parent)
-
-	// Convert spec TFVars to TerraformInputVars
-	tfVars := make(TerraformInputVars, 0)
-	if parent.Spec.TFVars != nil {
-		for _, v := range *parent.Spec.TFVars {
-			tfVars[v.Name] = v.Value
-		}
-	}
+	// The run status of the last sync, the Succeeded and Failed Events are only recorded when it changes.
+	prevPodStatus := status.PodStatus
 
 	// Terraform Pod data
-	tfp := TFPod{
-		Image:              image,
-		ImagePullPolicy:    imagePullPolicy,
-		Namespace:          parent.GetNamespace(),
-		ProjectID:          config.Project,
-		Workspace:          fmt.Sprintf("%s-%s", parent.GetNamespace(), parent.GetName()),
-		SourceData:         *sourceData,
-		ProviderConfigKeys: *providerConfigKeys,
-		BackendBucket:      backendBucket,
-		BackendPrefix:      backendPrefix,
-		TFParent:           parent.GetName(),
-		TFPlan:             tfplanfile,
-		TFInputs:           *tfInputVars,
-		TFVarsFrom:         *tfVarsFrom,
-		TFVars:             tfVars,
-	}
-
-	status.Sources.ConfigMapHashes = make([]tfv1.ConfigMapHash, 0)
-	for _, v := range sourceData.ConfigMapHashes {
-		status.Sources.ConfigMapHashes = append(status.Sources.ConfigMapHashes, v)
-	}
-	status.Sources.EmbeddedConfigMaps = make(tfv1.EmbeddedConfigMaps, 0)
-	for _, k := range sourceData.EmbeddedConfigMaps {
-		status.Sources.EmbeddedConfigMaps = append(status.Sources.EmbeddedConfigMaps, k)
+	tfp := makeTFPod(parent, providerConfigKeys, sourceData, tfInputVars, tfVarsFrom, tfplanfile)
+	if tfplanfile != "" {
+		// Hash of the plan from the TFPlanReady condition, matching the approved hash when approval is required.
+		tfp.TFPlanHash = status.TFPlanHash
 	}
 
+	status.Workspace = tfp.Workspace
+	status.StateFile = makeStateFilePath(tfp.Backend, tfp.Workspace)
+
 	if len(children.Pods) == 0 {
 		// New pod
 		podName := makeOrdinalPodName(parent, 0)
@@ -67,6 +40,9 @@ func reconcileTFPodReady(condition *tfv1.Condition, parent *tfv1.Terraform, stat
 		}
 		children.claimChildAndGetCurrent(pod, desiredChildren)
 		parent.Log("INFO", "Creating Pod/%s", podName)
+		recordEvent(parent, corev1.EventTypeNormal, EventReasonStarted, "Created Pod/%s", podName)
+		setRunStatus(parent, status, &tfp)
+		status.RunReason = ""
 		return condition.Status
 	}
 
@@ -85,89 +61,63 @@ func reconcileTFPodReady(condition *tfv1.Condition, parent *tfv1.Terraform, stat
 	currPod := children.Pods[podName]
 	podStatus := currPod.Status
 
+	// Record the run of pods created before the run status was tracked.
+	if status.RunSpecSig == "" {
+		setRunStatus(parent, status, &tfp)
+	}
+
+	// Start a new run when the spec or a source with trigger enabled changed since the last run was started.
+	if reason := getRerunReason(parent, status, sourceData); reason != "" && (podStatus.Phase == corev1.PodSucceeded || podStatus.Phase == corev1.PodFailed) {
+		if err := startNextRun(parent, status, children, desiredChildren, &tfp, reason); err != nil {
+			condition.Reason = fmt.Sprintf("Failed to create pod: %v", err)
+			return condition.Status
+		}
+		condition.Reason = fmt.Sprintf("%s: Pod/%s", reason, status.PodName)
+		return tfv1.ConditionFalse
+	}
+
+	// Explain why the current run was started.
+	if status.RunReason != "" {
+		condition.Message = fmt.Sprintf("Run started by: %s", status.RunReason)
+	}
+
 	// Check status of init containers
 	for _, cStatus := range podStatus.InitContainerStatuses {
 		switch cStatus.Name {
 		case GCS_TARBALL_CONTAINER_NAME:
-			switch podStatus.Phase {
-			case corev1.PodFailed:
+			if podStatus.Phase == corev1.PodFailed && cStatus.State.Terminated != nil && cStatus.State.Terminated.ExitCode != 0 {
+				// The terraform container did not run, retry the pod without checking it.
 				setFinalPodStatus(parent, status, cStatus, currPod, tfv1.PodStatusFailed)
-				maxRetry := getPodMaxAttempts(parent)
-
-				// Attempt retry
-				status.RetryCount++
-
-				reasons = append(reasons, fmt.Sprintf("Pod/%s.%s: Attempt (%d/%d): %s", podName, cStatus.Name, status.RetryCount, maxRetry, cStatus.State.Terminated.Message))
-
-				if status.RetryCount >= maxRetry {
-					// Retries exceeded, reset and attept again. This is a continuous retry loop with exponential backoff.
-					status.RetryCount = 0
-					status.RetryNextAt = ""
-				} else {
-					finishedAt, err := time.Parse(time.RFC3339, status.FinishedAt)
-					if err != nil {
-						parent.Log("ERROR", "Failed to parse time: %v", err)
-						reasons = append(reasons, "Internal error")
-					} else {
-						backoff := computeExponentialBackoff(status.RetryCount, tfDriverConfig.BackoffScale)
-						timeSinceFinished := time.Since(finishedAt)
-						if timeSinceFinished.Seconds() >= backoff {
-							// Done waiting for backoff.
-
-							// Create new pod
-							newPodName := makeOrdinalPodName(parent, (index + 1))
-							pod, err := tfp.makeTerraformPod(newPodName, parent.GetNamespace(), parent.GetTFKind(), nil)
-							if err != nil {
-								reasons = append(reasons, fmt.Sprintf("Pod/%s: Failed to create pod: %v", podName, err))
-								return condition.Status
-							}
-							children.claimChildAndGetCurrent(pod, desiredChildren)
-							parent.Log("INFO", "Creating Pod/%s", podName)
-							return condition.Status
-						}
-						nextAttemptTime := finishedAt.Add(time.Second * time.Duration(int64(backoff)))
-						status.RetryNextAt = nextAttemptTime.Format(time.RFC3339)
-					}
+				failedMessage := getFailedMessage(currPod, cStatus)
+				condition.Message = getMessageTail(failedMessage, TERMINATION_MESSAGE_LINES)
+				if isPodStatusTransition(prevPodStatus) {
+					recordEvent(parent, corev1.EventTypeWarning, EventReasonFailed, "Pod/%s.%s failed: %s", podName, cStatus.Name, getMessageSummary(failedMessage))
 				}
+
+				reasons = append(reasons, retryFailedPod(parent, status, children, desiredChildren, &tfp, index, cStatus.Name, failedMessage)...)
+				condition.Reason = strings.Join(reasons, ",")
+				return tfv1.ConditionFalse
 			}
 		}
 	} // End init container check.
 
+	// Report pods that are stuck pending, pods that have started are killed after the timeout.
+	if reason := getPodPendingReason(currPod); reason != "" {
+		status.PodName = currPod.GetName()
+		status.PodStatus = tfv1.PodStatusRunning
+		condition.Reason = fmt.Sprintf("Pod/%s: PENDING: %s", podName, reason)
+		return tfv1.ConditionFalse
+	}
+
 	// Check pod containers
 	for _, cStatus := range podStatus.ContainerStatuses {
 		switch cStatus.Name {
 		case TERRAFORM_CONTAINER_NAME:
 
-			// Populate status.TFPlan from completed pod annotation.
-			if plan, ok := currPod.Annotations["terraform-plan"]; ok == true {
-				status.TFPlan = plan
-
-				// Parse the plan
-				summary, err := parseTerraformPlan(plan)
-				if err != nil {
-					parent.Log("ERROR", "Failed to parse plan: %s, %v", plan, err)
-					condition.Reason = "Internal error"
-					return condition.Status
-				}
-
-				status.TFPlanDiff = &summary
-			}
-
-			// Populate status.TFOutput map from completed pod annotation.
-			if output, ok := currPod.Annotations["terraform-output"]; ok == true {
-				outputVars, err := makeOutputVars(output)
-				if err != nil {
-					parent.Log("ERROR", "Pod/%s: Failed to parse output vars: %v", podName, err)
-					condition.Reason = "Internal error"
-					return condition.Status
-				}
-				status.TFOutput = &outputVars
-
-				// Create Secret with output var map
-				secretName := fmt.Sprintf("%s-tfapply-outputs", parent.GetName())
-				secret := makeOutputVarsSecret(secretName, parent.GetNamespace(), outputVars)
-				children.claimChildAndGetCurrent(secret, desiredChildren)
-				status.TFOutputSecret = secret.GetName()
+			// Populate the plan and outputs from the pod annotations.
+			if err := setPodAnnotationStatus(parent, status, children, desiredChildren, currPod); err != nil {
+				condition.Reason = "Internal error"
+				return condition.Status
 			}
 
 			switch podStatus.Phase {
@@ -176,42 +126,30 @@ func reconcileTFPodReady(condition *tfv1.Condition, parent *tfv1.Terraform, stat
 				setFinalPodStatus(parent, status, cStatus, currPod, tfv1.PodStatusPassed)
 				status.RetryNextAt = ""
 				newStatus = tfv1.ConditionTrue
+				if isPodStatusTransition(prevPodStatus) {
+					recordEvent(parent, corev1.EventTypeNormal, EventReasonSucceeded, "Pod/%s completed in %s", podName, status.Duration)
+				}
+
+				// Start the next scheduled run, missed runs are skipped.
+				started, err := startScheduledRun(parent, status, children, desiredChildren, &tfp)
+				if err != nil {
+					reasons = append(reasons, fmt.Sprintf("Failed to start scheduled run: %v", err))
+				} else if started {
+					reasons = append(reasons, fmt.Sprintf("Scheduled run: Pod/%s", status.PodName))
+					newStatus = tfv1.ConditionFalse
+				}
 
 			case corev1.PodFailed:
 				// Failed
 				setFinalPodStatus(parent, status, cStatus, currPod, tfv1.PodStatusFailed)
-				maxRetry := getPodMaxAttempts(parent)
-
-				reasons = append(reasons, fmt.Sprintf("Pod/%s.%s: Attempt %d %s", podName, cStatus.Name, status.RetryCount, cStatus.State.Terminated.Message))
-
-				finishedAt, err := time.Parse(time.RFC3339, status.FinishedAt)
-				if err != nil {
-					parent.Log("ERROR", "Failed to parse time: %v", err)
-					reasons = append(reasons, "Internal error")
-				} else {
-					backoff := computeExponentialBackoff(status.RetryCount%maxRetry, tfDriverConfig.BackoffScale)
-					timeSinceFinished := time.Since(finishedAt)
-					if timeSinceFinished.Seconds() >= backoff {
-						// Done waiting for backoff.
-
-						// Attempt retry
-						status.RetryCount++
-
-						// Generate a new ordinal pod child
-						// Create new pod
-						newPodName := makeOrdinalPodName(parent, (index + 1))
-						pod, err := tfp.makeTerraformPod(newPodName, parent.GetNamespace(), parent.GetTFKind(), nil)
-						if err != nil {
-							reasons = append(reasons, fmt.Sprintf("Pod/%s: Failed to create pod: %v", podName, err))
-							return condition.Status
-						}
-						children.claimChildAndGetCurrent(pod, desiredChildren)
-						parent.Log("INFO", "Creating Pod/%s", podName)
-					}
-					nextAttemptTime := finishedAt.Add(time.Second * time.Duration(int64(backoff)))
-					status.RetryNextAt = nextAttemptTime.Format(time.RFC3339)
+				failedMessage := getFailedMessage(currPod, cStatus)
+				condition.Message = getMessageTail(failedMessage, TERMINATION_MESSAGE_LINES)
+				if isPodStatusTransition(prevPodStatus) {
+					recordEvent(parent, corev1.EventTypeWarning, EventReasonFailed, "Pod/%s.%s failed: %s", podName, cStatus.Name, getMessageSummary(failedMessage))
 				}
 
+				reasons = append(reasons, retryFailedPod(parent, status, children, desiredChildren, &tfp, index, cStatus.Name, failedMessage)...)
+
 			default:
 				// Active
 				if cStatus.State.Running != nil {
@@ -231,21 +169,305 @@ func reconcileTFPodReady(condition *tfv1.Condition, parent *tfv1.Terraform, stat
 	return newStatus
 }
 
+// retryFailedPod creates the next ordinal pod after the backoff when the failure is retryable with the retry policy.
+// Returns the reasons for the condition.
+func retryFailedPod(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}, tfp *TFPod, index int, containerName string, failedMessage string) []string {
+	reasons := make([]string, 0)
+	podName := makeOrdinalPodName(parent, index)
+	maxAttempts := getPodMaxAttempts(parent)
+	attempt := status.RetryCount + 1
+
+	reasons = append(reasons, fmt.Sprintf("Pod/%s.%s: Attempt %d: %s", podName, containerName, attempt, getMessageSummary(failedMessage)))
+
+	if rule := getTerminalRetryRule(parent, failedMessage); rule != "" {
+		// The error matched a rule with the Fail action, the run is not retried.
+		status.RetryNextAt = ""
+		reasons = append(reasons, fmt.Sprintf("Not retrying, error matched: %s", rule))
+		return append(reasons, startScheduledRunAfterFailure(parent, status, children, desiredChildren, tfp)...)
+	}
+
+	if getRetryMode(parent) == tfv1.RetryModeFail && attempt >= maxAttempts {
+		status.RetryNextAt = ""
+		reasons = append(reasons, fmt.Sprintf("Not retrying, %d attempts exhausted", maxAttempts))
+		return append(reasons, startScheduledRunAfterFailure(parent, status, children, desiredChildren, tfp)...)
+	}
+
+	finishedAt, err := time.Parse(time.RFC3339, status.FinishedAt)
+	if err != nil {
+		parent.Log("ERROR", "Failed to parse time: %v", err)
+		return append(reasons, "Internal error")
+	}
+
+	// In the Continuous mode the backoff starts over after the max attempts.
+	backoff := getRetryBackoff(parent, status.RetryCount%maxAttempts)
+	if time.Since(finishedAt).Seconds() >= backoff {
+		// Done waiting for backoff, create the next ordinal pod.
+		status.RetryCount++
+		newPodName := makeOrdinalPodName(parent, (index + 1))
+		pod, err := tfp.makeTerraformPod(newPodName, parent.GetNamespace(), parent.GetTFKind(), nil)
+		if err != nil {
+			return append(reasons, fmt.Sprintf("Pod/%s: Failed to create pod: %v", newPodName, err))
+		}
+		children.claimChildAndGetCurrent(pod, desiredChildren)
+		parent.Log("INFO", "Creating Pod/%s", newPodName)
+		recordEvent(parent, corev1.EventTypeNormal, EventReasonRetrying, "Created Pod/%s, attempt %d", newPodName, status.RetryCount+1)
+		setRunStatus(parent, status, tfp)
+		status.RetryNextAt = ""
+		status.PodStatus = tfv1.PodStatusRunning
+		return reasons
+	}
+	nextAttemptTime := finishedAt.Add(time.Second * time.Duration(int64(backoff)))
+	status.RetryNextAt = nextAttemptTime.Format(time.RFC3339)
+
+	return reasons
+}
+
+// getTerminalRetryRule returns the pattern of the first retry rule matching the error output if its action is Fail,
+// or an empty string when the error is retryable. Errors that match no rule are retryable.
+func getTerminalRetryRule(parent *tfv1.Terraform, failedMessage string) string {
+	if parent.Spec.RetryPolicy == nil {
+		return ""
+	}
+	for _, rule := range parent.Spec.RetryPolicy.Rules {
+		// Patterns were validated with the spec.
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil || !re.MatchString(failedMessage) {
+			continue
+		}
+		if rule.Action == tfv1.RetryActionFail {
+			return rule.Pattern
+		}
+		return ""
+	}
+	return ""
+}
+
+func getRetryMode(parent *tfv1.Terraform) tfv1.RetryMode {
+	if parent.Spec.RetryPolicy != nil && parent.Spec.RetryPolicy.Mode != "" {
+		return parent.Spec.RetryPolicy.Mode
+	}
+	return tfv1.RetryModeContinuous
+}
+
+// getRetryBackoff returns the exponential backoff in seconds, capped at the max backoff.
+func getRetryBackoff(parent *tfv1.Terraform, retryCount int32) float64 {
+	maxBackoff := tfDriverConfig.MaxBackoff
+	if parent.Spec.RetryPolicy != nil && parent.Spec.RetryPolicy.MaxBackoff != "" {
+		// MaxBackoff was validated with the spec.
+		maxBackoff, _ = time.ParseDuration(parent.Spec.RetryPolicy.MaxBackoff)
+	}
+	backoff := computeExponentialBackoff(retryCount, tfDriverConfig.BackoffScale)
+	if maxBackoff > 0 && backoff > maxBackoff.Seconds() {
+		return maxBackoff.Seconds()
+	}
+	return backoff
+}
+
+// getRerunReason returns the reason to start a new run when the spec, the approved plan or a ConfigMap source with trigger enabled
+// changed since the last run was started, or an empty string.
+func getRerunReason(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, sourceData *TerraformConfigSourceData) string {
+	if status.RunSpecSig != parent.GetSig() {
+		return "Spec changed"
+	}
+	if isApprovedPlanChanged(parent, status) {
+		return fmt.Sprintf("Approved plan changed: %s", status.TFPlan)
+	}
+	if changes := getTriggeredSourceChanges(parent, status, sourceData); len(changes) > 0 {
+		return fmt.Sprintf("Source changed: %s", strings.Join(changes, ","))
+	}
+	return ""
+}
+
+// isApprovedPlanChanged returns true if a plan other than the one applied by the last run was approved.
+// The plan is approved with the spec or the annotations, neither is part of the signature.
+// Runs started before the applied plan was recorded are not re-run.
+func isApprovedPlanChanged(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus) bool {
+	if parent.Spec.TFPlan == "" || parent.Spec.Approval == nil || !parent.Spec.Approval.Required {
+		return false
+	}
+	if status.RunPlanFile == "" {
+		return false
+	}
+	return status.TFPlan != status.RunPlanFile || status.TFPlanHash != status.RunPlanHash
+}
+
+// setPodAnnotationStatus populates the plan and outputs in the status from the annotations written by the terraform pod.
+// The outputs are also stored in a Secret, sensitive values are only stored in the Secret.
+func setPodAnnotationStatus(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}, pod corev1.Pod) error {
+	// Populate status.TFPlan from completed pod annotation.
+	if plan, ok := pod.Annotations["terraform-plan"]; ok == true {
+		status.TFPlan = plan
+
+		// Parse the plan
+		summary, err := parseTerraformPlan(plan)
+		if err != nil {
+			parent.Log("ERROR", "Failed to parse plan: %s, %v", plan, err)
+			return err
+		}
+
+		status.TFPlanDiff = &summary
+		status.TFPlanHash = pod.Annotations["terraform-plan-hash"]
+	}
+
+	// Populate status.TFOutput map from completed pod annotation.
+	if output, ok := pod.Annotations["terraform-output"]; ok == true {
+		outputVars, err := makeOutputVars(output)
+		if err != nil {
+			parent.Log("ERROR", "Pod/%s: Failed to parse output vars: %v", pod.GetName(), err)
+			return err
+		}
+
+		// Create Secret with output var map
+		secretName := fmt.Sprintf("%s-tfapply-outputs", parent.GetName())
+
+		// The salt of the sensitive output hashes is kept in the Secret so that the hashes are stable across syncs.
+		salt := children.Secrets[secretName].Annotations[tfv1.AnnotationOutputsHashSalt]
+		if salt == "" {
+			if salt, err = makeSalt(); err != nil {
+				parent.Log("ERROR", "Failed to make outputs salt: %v", err)
+				return err
+			}
+		}
+
+		if parent.Spec.OutputsSecretOnly {
+			status.TFOutput = nil
+		} else {
+			statusVars := redactOutputVars(outputVars, salt)
+			status.TFOutput = &statusVars
+		}
+
+		secret := makeOutputVarsSecret(secretName, parent.GetNamespace(), outputVars, salt)
+		children.claimChildAndGetCurrent(secret, desiredChildren)
+		status.TFOutputSecret = secret.GetName()
+	}
+
+	return nil
+}
+
+// getTriggeredSourceChanges returns the ConfigMap sources with trigger enabled whose content hash differs from the last run.
+func getTriggeredSourceChanges(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, sourceData *TerraformConfigSourceData) []string {
+	changes := make([]string, 0)
+
+	lastHashes := make(map[string]string, 0)
+	for _, v := range status.Sources.ConfigMapHashes {
+		lastHashes[v.Name] = v.Hash
+	}
+
+	for _, source := range parent.Spec.Sources {
+		if source.ConfigMap == nil || source.ConfigMap.Trigger == false {
+			continue
+		}
+		name := source.ConfigMap.Name
+		curr, ok := sourceData.ConfigMapHashes[name]
+		if !ok {
+			continue
+		}
+		if last, ok := lastHashes[name]; ok && last != curr.Hash {
+			changes = append(changes, fmt.Sprintf("ConfigMap/%s %s -> %s", name, shortHash(last), shortHash(curr.Hash)))
+		}
+	}
+
+	return changes
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 8 {
+		return hash[0:8]
+	}
+	return hash
+}
+
 func computeExponentialBackoff(retryCount int32, scaleFactor float64) float64 {
 	return ((math.Pow(2, float64(retryCount+1)) - 1) / 2.0) * scaleFactor
 }
 
+// getPodPendingReason returns why a pending pod has not started, or an empty string.
+// Unschedulable pods and containers that cannot be created, for example because of image pull errors, are reported.
+func getPodPendingReason(pod corev1.Pod) string {
+	if pod.Status.Phase != corev1.PodPending {
+		return ""
+	}
+
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse && c.Reason != "" {
+			return fmt.Sprintf("%s: %s", c.Reason, c.Message)
+		}
+	}
+
+	cStatuses := make([]corev1.ContainerStatus, 0)
+	cStatuses = append(cStatuses, pod.Status.InitContainerStatuses...)
+	cStatuses = append(cStatuses, pod.Status.ContainerStatuses...)
+	for _, cStatus := range cStatuses {
+		waiting := cStatus.State.Waiting
+		if waiting == nil || waiting.Reason == "" || waiting.Reason == "ContainerCreating" || waiting.Reason == "PodInitializing" {
+			continue
+		}
+		return fmt.Sprintf("%s: %s: %s", cStatus.Name, waiting.Reason, waiting.Message)
+	}
+
+	return ""
+}
+
+// getFailedMessage returns the message of the failed container, pods killed after the timeout are reported as TimedOut.
+func getFailedMessage(pod corev1.Pod, cStatus corev1.ContainerStatus) string {
+	if pod.Status.Reason == "DeadlineExceeded" {
+		return fmt.Sprintf("TimedOut: %s", pod.Status.Message)
+	}
+	if cStatus.State.Terminated != nil {
+		return cStatus.State.Terminated.Message
+	}
+	return pod.Status.Message
+}
+
+// getMessageSummary returns the first line of the termination message.
+func getMessageSummary(message string) string {
+	for _, line := range strings.Split(message, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// getMessageTail returns the last lines of the termination message.
+func getMessageTail(message string, lines int) string {
+	messageLines := strings.Split(strings.TrimRight(message, "\n"), "\n")
+	if len(messageLines) > lines {
+		messageLines = messageLines[len(messageLines)-lines:]
+	}
+	return strings.Join(messageLines, "\n")
+}
+
+// getContainerFinishedAt returns when the container terminated.
+// Containers of pods killed after the timeout may not have a terminated state, the pod Ready transition is used instead.
+func getContainerFinishedAt(pod corev1.Pod, cStatus corev1.ContainerStatus) time.Time {
+	if cStatus.State.Terminated != nil {
+		return cStatus.State.Terminated.FinishedAt.Time
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.LastTransitionTime.Time
+		}
+	}
+	if pod.Status.StartTime != nil {
+		return pod.Status.StartTime.Time
+	}
+	return pod.GetCreationTimestamp().Time
+}
+
 func setFinalPodStatus(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, cStatus corev1.ContainerStatus, pod corev1.Pod, podStatus tfv1.PodStatus) {
+	finishedAt := getContainerFinishedAt(pod, cStatus)
+
 	status.PodStatus = podStatus
-	status.FinishedAt = cStatus.State.Terminated.FinishedAt.Format(time.RFC3339)
+	status.FinishedAt = finishedAt.Format(time.RFC3339)
 
-	if status.StartedAt == "" {
+	if status.StartedAt == "" && pod.Status.StartTime != nil {
 		status.StartedAt = pod.Status.StartTime.Format(time.RFC3339)
 	}
 
 	// Set Duration in seconds
 	startTime, _ := time.Parse(time.RFC3339, status.StartedAt)
-	duration := cStatus.State.Terminated.FinishedAt.Sub(startTime)
+	duration := finishedAt.Sub(startTime)
 	status.Duration = fmt.Sprintf("%02.0fm%02.0fs", duration.Minutes(), duration.Seconds())
 }
 
@@ -254,6 +476,9 @@ func getPodMaxAttempts(parent *tfv1.Terraform) int32 {
 	if parent.Spec.MaxAttempts != nil {
 		maxAttempts = *parent.Spec.MaxAttempts
 	}
+	if parent.Spec.RetryPolicy != nil && parent.Spec.RetryPolicy.MaxAttempts != nil {
+		maxAttempts = *parent.Spec.RetryPolicy.MaxAttempts
+	}
 	return maxAttempts
 }
 
@@ -277,3 +502,16 @@ func makeOutputVars(data string) ([]tfv1.TerraformOutputVar, error) {
 	}
 	return outputVars, err
 }
+
+// redactOutputVars returns a copy of the output vars with the values of sensitive outputs replaced by their salted hash.
+func redactOutputVars(vars []tfv1.TerraformOutputVar, salt string) []tfv1.TerraformOutputVar {
+	redacted := make([]tfv1.TerraformOutputVar, 0)
+	for _, v := range vars {
+		if v.Sensitive {
+			v.Hash = toSaltedSha256(salt, v.Value)
+			v.Value = ""
+		}
+		redacted = append(redacted, v)
+	}
+	return redacted
+}
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetTriggeredSourceChanges(t *testing.T) {
+	parent := tfv1.Terraform{
+		Spec: &tfv1.TerraformSpec{
+			Sources: []tfv1.TerraformConfigSource{
+				{ConfigMap: &tfv1.TerraformSourceConfigMap{Name: "cm-trigger", Trigger: true}},
+				{ConfigMap: &tfv1.TerraformSourceConfigMap{Name: "cm-no-trigger"}},
+			},
+		},
+	}
+
+	status := tfv1.TerraformOperatorStatus{
+		Sources: tfv1.TerraformOperatorStatusSources{
+			ConfigMapHashes: []tfv1.ConfigMapHash{
+				{Name: "cm-trigger", Hash: "1111111111"},
+				{Name: "cm-no-trigger", Hash: "3333333333"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		hashes   map[string]tfv1.ConfigMapHash
+		expected []string
+	}{
+		{
+			"unchanged",
+			map[string]tfv1.ConfigMapHash{
+				"cm-trigger":    {Name: "cm-trigger", Hash: "1111111111"},
+				"cm-no-trigger": {Name: "cm-no-trigger", Hash: "3333333333"},
+			},
+			[]string{},
+		},
+		{
+			"changed without trigger",
+			map[string]tfv1.ConfigMapHash{
+				"cm-trigger":    {Name: "cm-trigger", Hash: "1111111111"},
+				"cm-no-trigger": {Name: "cm-no-trigger", Hash: "4444444444"},
+			},
+			[]string{},
+		},
+		{
+			"changed with trigger",
+			map[string]tfv1.ConfigMapHash{
+				"cm-trigger":    {Name: "cm-trigger", Hash: "2222222222"},
+				"cm-no-trigger": {Name: "cm-no-trigger", Hash: "3333333333"},
+			},
+			[]string{"ConfigMap/cm-trigger 11111111 -> 22222222"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sourceData := TerraformConfigSourceData{ConfigMapHashes: tc.hashes}
+			changes := getTriggeredSourceChanges(&parent, &status, &sourceData)
+			if !reflect.DeepEqual(tc.expected, changes) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, changes)
+			}
+		})
+	}
+}
+
+func TestGetPodPendingReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   corev1.PodStatus
+		expected string
+	}{
+		{
+			"running",
+			corev1.PodStatus{Phase: corev1.PodRunning},
+			"",
+		},
+		{
+			"unschedulable",
+			corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available"},
+				},
+			},
+			"Unschedulable: 0/3 nodes are available",
+		},
+		{
+			"image pull error",
+			corev1.PodStatus{
+				Phase: corev1.PodPending,
+				InitContainerStatuses: []corev1.ContainerStatus{
+					{Name: GCS_TARBALL_CONTAINER_NAME, State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}},
+				},
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: TERRAFORM_CONTAINER_NAME, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}}},
+				},
+			},
+			"terraform: ImagePullBackOff: Back-off pulling image",
+		},
+		{
+			"container creating",
+			corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: TERRAFORM_CONTAINER_NAME, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+				},
+			},
+			"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := getPodPendingReason(corev1.Pod{Status: tc.status})
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetFailedMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		pod      corev1.Pod
+		cStatus  corev1.ContainerStatus
+		expected string
+	}{
+		{
+			"terminated",
+			corev1.Pod{},
+			corev1.ContainerStatus{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: "Error applying plan"}}},
+			"Error applying plan",
+		},
+		{
+			"timed out without terminated state",
+			corev1.Pod{Status: corev1.PodStatus{Reason: "DeadlineExceeded", Message: "Pod was active on the node longer than the specified deadline"}},
+			corev1.ContainerStatus{State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			"TimedOut: Pod was active on the node longer than the specified deadline",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := getFailedMessage(tc.pod, tc.cStatus)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetMessageTail(t *testing.T) {
+	message := "Error: Error creating Network: googleapi: Error 409: already exists\n\n  on network.tf line 1, in resource \"google_compute_network\" \"default\":\n   1: resource \"google_compute_network\" \"default\" {\n"
+
+	tests := []struct {
+		name     string
+		lines    int
+		summary  string
+		expected string
+	}{
+		{
+			"all lines",
+			20,
+			"Error: Error creating Network: googleapi: Error 409: already exists",
+			"Error: Error creating Network: googleapi: Error 409: already exists\n\n  on network.tf line 1, in resource \"google_compute_network\" \"default\":\n   1: resource \"google_compute_network\" \"default\" {",
+		},
+		{
+			"last lines",
+			2,
+			"Error: Error creating Network: googleapi: Error 409: already exists",
+			"  on network.tf line 1, in resource \"google_compute_network\" \"default\":\n   1: resource \"google_compute_network\" \"default\" {",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := getMessageSummary(message); got != tc.summary {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.summary, got)
+			}
+			if got := getMessageTail(message, tc.lines); got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetRetryBackoff(t *testing.T) {
+	tfDriverConfig.BackoffScale = 5.0
+	tfDriverConfig.MaxBackoff = 10 * time.Minute
+
+	tests := []struct {
+		name        string
+		retryPolicy *tfv1.TerraformRetryPolicy
+		retryCount  int32
+		expected    float64
+	}{
+		{"first retry", nil, 0, 2.5},
+		{"capped by driver config", nil, 10, 600},
+		{"capped by retry policy", &tfv1.TerraformRetryPolicy{MaxBackoff: "1m"}, 10, 60},
+		{"below retry policy cap", &tfv1.TerraformRetryPolicy{MaxBackoff: "1m"}, 2, 17.5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := &tfv1.Terraform{Spec: &tfv1.TerraformSpec{RetryPolicy: tc.retryPolicy}}
+			got := getRetryBackoff(parent, tc.retryCount)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetTerminalRetryRule(t *testing.T) {
+	retryPolicy := &tfv1.TerraformRetryPolicy{
+		Rules: []tfv1.TerraformRetryRule{
+			{Pattern: "Error 429|rateLimitExceeded", Action: tfv1.RetryActionRetry},
+			{Pattern: "^Error: (Invalid|Unsupported|Missing required)", Action: tfv1.RetryActionFail},
+			{Pattern: "Error", Action: tfv1.RetryActionFail},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{"rate limited", "Error: googleapi: Error 429: rateLimitExceeded", ""},
+		{"config error", "Error: Unsupported argument", "^Error: (Invalid|Unsupported|Missing required)"},
+		{"other error", "Error: something else", "Error"},
+		{"no match", "panic: runtime error", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := &tfv1.Terraform{Spec: &tfv1.TerraformSpec{RetryPolicy: retryPolicy}}
+			got := getTerminalRetryRule(parent, tc.message)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetPodAnnotationStatusOutputs(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-tfapply-0",
+			Annotations: map[string]string{
+				"terraform-output": `{"ip": {"type": "string", "value": "10.0.0.1"}, "password": {"sensitive": true, "type": "string", "value": "hunter2"}}`,
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		outputsSecretOnly bool
+		expected          *[]tfv1.TerraformOutputVar
+	}{
+		{
+			"redacted",
+			false,
+			&[]tfv1.TerraformOutputVar{
+				{Name: "ip", Type: "string", Value: "10.0.0.1"},
+				{Name: "password", Sensitive: true, Type: "string", Hash: toSaltedSha256("abc123", "hunter2")},
+			},
+		},
+		{
+			"secret only",
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := makeTestTerraform(tfv1.TFKindApply, "test")
+			parent.Spec.OutputsSecretOnly = tc.outputsSecretOnly
+
+			var status tfv1.TerraformOperatorStatus
+			// The salt of the current Secret is kept.
+			children := TerraformChildren{Secrets: map[string]corev1.Secret{
+				"test-tfapply-outputs": {ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{tfv1.AnnotationOutputsHashSalt: "abc123"}}},
+			}}
+			desiredChildren := make([]interface{}, 0)
+
+			if err := setPodAnnotationStatus(&parent, &status, &children, &desiredChildren, pod); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(tc.expected, status.TFOutput) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, status.TFOutput)
+			}
+
+			expectedData := map[string][]byte{"ip": []byte("10.0.0.1"), "password": []byte("hunter2")}
+			if len(desiredChildren) != 1 {
+				t.Fatalf("expected the outputs Secret, got: %#v", desiredChildren)
+			}
+			secret := desiredChildren[0].(corev1.Secret)
+			if !reflect.DeepEqual(expectedData, secret.Data) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", expectedData, secret.Data)
+			}
+			if salt := secret.Annotations[tfv1.AnnotationOutputsHashSalt]; salt != "abc123" {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", "abc123", salt)
+			}
+		})
+	}
+}
+
+func TestGetRerunReasonApprovedPlan(t *testing.T) {
+	parent := makeTestTerraform(tfv1.TFKindApply, "test")
+	parent.Spec.TFPlan = "test"
+	parent.Spec.Approval = &tfv1.TerraformApproval{Required: true}
+
+	tests := []struct {
+		name     string
+		required bool
+		status   tfv1.TerraformOperatorStatus
+		expected string
+	}{
+		{
+			"applied plan",
+			true,
+			tfv1.TerraformOperatorStatus{TFPlan: "gs://bucket/a.tfplan", TFPlanHash: "aaa", RunPlanFile: "gs://bucket/a.tfplan", RunPlanHash: "aaa"},
+			"",
+		},
+		{
+			"new plan approved",
+			true,
+			tfv1.TerraformOperatorStatus{TFPlan: "gs://bucket/b.tfplan", TFPlanHash: "bbb", RunPlanFile: "gs://bucket/a.tfplan", RunPlanHash: "aaa"},
+			"Approved plan changed: gs://bucket/b.tfplan",
+		},
+		{
+			"run not recorded",
+			true,
+			tfv1.TerraformOperatorStatus{TFPlan: "gs://bucket/b.tfplan", TFPlanHash: "bbb"},
+			"",
+		},
+		{
+			"approval not required",
+			false,
+			tfv1.TerraformOperatorStatus{TFPlan: "gs://bucket/b.tfplan", TFPlanHash: "bbb", RunPlanFile: "gs://bucket/a.tfplan", RunPlanHash: "aaa"},
+			"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parent.Spec.Approval.Required = tc.required
+			status := tc.status
+			status.RunSpecSig = parent.GetSig()
+
+			got := getRerunReason(&parent, &status, &TerraformConfigSourceData{})
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetRerunReasonSpecChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		edit     func(spec *tfv1.TerraformSpec)
+		expected string
+	}{
+		{
+			"schedule",
+			func(spec *tfv1.TerraformSpec) { spec.Schedule = "@hourly" },
+			"",
+		},
+		{
+			"timeout",
+			func(spec *tfv1.TerraformSpec) { spec.Timeout = "30m" },
+			"",
+		},
+		{
+			"retry policy",
+			func(spec *tfv1.TerraformSpec) { spec.RetryPolicy = &tfv1.TerraformRetryPolicy{} },
+			"",
+		},
+		{
+			"max attempts",
+			func(spec *tfv1.TerraformSpec) { maxAttempts := int32(8); spec.MaxAttempts = &maxAttempts },
+			"",
+		},
+		{
+			"tfvars",
+			func(spec *tfv1.TerraformSpec) { spec.TFVars = &[]tfv1.TFVar{{Name: "region", Value: "us-west1"}} },
+			"Spec changed",
+		},
+		{
+			"image",
+			func(spec *tfv1.TerraformSpec) { spec.Image = "gcr.io/cloud-solutions-group/terraform-pod:latest" },
+			"Spec changed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := makeTestTerraform(tfv1.TFKindApply, "test")
+			status := tfv1.TerraformOperatorStatus{RunSpecSig: parent.GetSig()}
+
+			tc.edit(parent.Spec)
+
+			got := getRerunReason(&parent, &status, &TerraformConfigSourceData{})
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -1,23 +1,52 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
 
 	"cloud.google.com/go/compute/metadata"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 )
 
 // Config is the configuration structure used by the controller.
 type Config struct {
-	Project    string
-	ProjectNum string
-	clientset  *kubernetes.Clientset
+	Project        string
+	ProjectNum     string
+	ControllerMode string
+	AdmissionCert  string
+	AdmissionKey   string
+	clientset      *kubernetes.Clientset
+	dynClient      dynamic.Interface
+	recorder       record.EventRecorder
 }
 
 func (c *Config) loadAndValidate() error {
 	var err error
 
+	// CONTROLLER_MODE is optional
+	if mode, ok := os.LookupEnv("CONTROLLER_MODE"); ok == true {
+		c.ControllerMode = mode
+	} else {
+		c.ControllerMode = ControllerModeMetacontroller
+	}
+	if c.ControllerMode != ControllerModeMetacontroller && c.ControllerMode != ControllerModeNative {
+		return fmt.Errorf("Invalid CONTROLLER_MODE: %s, must be one of: %s, %s", c.ControllerMode, ControllerModeMetacontroller, ControllerModeNative)
+	}
+
+	// ADMISSION_TLS_CERT_FILE and ADMISSION_TLS_KEY_FILE are optional, the admission webhooks are served when the certificate exists.
+	c.AdmissionCert, _ = os.LookupEnv("ADMISSION_TLS_CERT_FILE")
+	c.AdmissionKey, _ = os.LookupEnv("ADMISSION_TLS_KEY_FILE")
+	if (c.AdmissionCert == "") != (c.AdmissionKey == "") {
+		return fmt.Errorf("ADMISSION_TLS_CERT_FILE and ADMISSION_TLS_KEY_FILE must be set together")
+	}
+
 	if c.Project == "" {
 		log.Printf("[INFO] Fetching Project ID from Compute metadata API...")
 		c.Project, err = metadata.ProjectID()
@@ -45,5 +74,15 @@ func (c *Config) loadAndValidate() error {
 	}
 	c.clientset = clientset
 
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	c.recorder = broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "terraform-operator"})
+
+	dynClient, err := dynamic.NewForConfig(clusterConfig)
+	if err != nil {
+		return err
+	}
+	c.dynClient = dynClient
+
 	return nil
 }
@@ -0,0 +1,581 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Controller modes
+const (
+	// ControllerModeMetacontroller serves the CompositeController sync and finalize webhooks.
+	ControllerModeMetacontroller = "metacontroller"
+	// ControllerModeNative watches the custom resources and applies the children without Metacontroller.
+	ControllerModeNative = "native"
+)
+
+const (
+	// nativeFinalizer is added to parents with spec.destroyOnDelete in native controller mode.
+	nativeFinalizer = "ctl.isla.solutions/destroy-on-delete"
+
+	// ownerUIDIndex indexes the children by the UID of their controller owner.
+	ownerUIDIndex = "ownerUID"
+
+	// parentLabel is set on every child, the child informers only watch objects with this label.
+	parentLabel = "terraform-parent"
+
+	// nativeResyncPeriod is the interval between syncs of each parent, same as the CompositeController resyncPeriodSeconds.
+	nativeResyncPeriod = 30 * time.Second
+
+	// nativeWorkers is the number of parents synced concurrently.
+	nativeWorkers = 4
+)
+
+// NativeController drives sync() from informers and a workqueue.
+// Children are owned by the parent through controller ownerReferences and are garbage collected with it.
+type NativeController struct {
+	clientset   kubernetes.Interface
+	dynClient   dynamic.Interface
+	tfInformers *TerraformInformers
+	factory     informers.SharedInformerFactory
+	pods        cache.SharedIndexInformer
+	configMaps  cache.SharedIndexInformer
+	secrets     cache.SharedIndexInformer
+	jobs        cache.SharedIndexInformer
+	queue       workqueue.RateLimitingInterface
+	resync      time.Duration
+}
+
+func newNativeController(clientset kubernetes.Interface, dynClient dynamic.Interface, tfInformers *TerraformInformers, resync time.Duration) *NativeController {
+	c := &NativeController{
+		clientset:   clientset,
+		dynClient:   dynClient,
+		tfInformers: tfInformers,
+		factory: informers.NewFilteredSharedInformerFactory(clientset, 0, metav1.NamespaceAll, func(opts *metav1.ListOptions) {
+			opts.LabelSelector = parentLabel
+		}),
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "terraform"),
+		resync: resync,
+	}
+
+	for kind, informer := range tfInformers.informers {
+		kind := kind
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) { c.enqueue(kind, obj) },
+			UpdateFunc: func(old, obj interface{}) {
+				if parentChanged(old, obj) {
+					c.enqueue(kind, obj)
+				}
+			},
+			DeleteFunc: func(obj interface{}) { c.enqueue(kind, obj) },
+		})
+	}
+
+	c.pods = c.factory.Core().V1().Pods().Informer()
+	c.configMaps = c.factory.Core().V1().ConfigMaps().Informer()
+	c.secrets = c.factory.Core().V1().Secrets().Informer()
+	c.jobs = c.factory.Batch().V1().Jobs().Informer()
+
+	for _, informer := range []cache.SharedIndexInformer{c.pods, c.configMaps, c.secrets, c.jobs} {
+		informer.AddIndexers(cache.Indexers{ownerUIDIndex: ownerUIDIndexFunc})
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueOwner,
+			UpdateFunc: func(old, obj interface{}) { c.enqueueOwner(obj) },
+			DeleteFunc: c.enqueueOwner,
+		})
+	}
+
+	return c
+}
+
+// Run starts the child informers and the workers, blocking until the stop channel is closed.
+// The Terraform informers must already be started.
+func (c *NativeController) Run(workers int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	c.factory.Start(stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, c.pods.HasSynced, c.configMaps.HasSynced, c.secrets.HasSynced, c.jobs.HasSynced) {
+		return fmt.Errorf("Timed out waiting for child caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	log.Printf("[INFO] Started native controller with %d workers", workers)
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *NativeController) enqueue(kind tfv1.TFKind, obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(fmt.Sprintf("%s/%s", kind, key))
+}
+
+// parentChanged returns true if the parent update needs a sync.
+// Status updates do not change the generation and are ignored, otherwise each status write would trigger another sync.
+// Annotation and finalizer changes do not change the generation either but are read by the sync.
+func parentChanged(old, obj interface{}) bool {
+	oldMeta, err := meta.Accessor(old)
+	if err != nil {
+		return true
+	}
+	newMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return true
+	}
+	if oldMeta.GetGeneration() != newMeta.GetGeneration() {
+		return true
+	}
+	return !reflect.DeepEqual(oldMeta.GetAnnotations(), newMeta.GetAnnotations()) ||
+		!reflect.DeepEqual(oldMeta.GetFinalizers(), newMeta.GetFinalizers()) ||
+		!reflect.DeepEqual(oldMeta.GetDeletionTimestamp(), newMeta.GetDeletionTimestamp())
+}
+
+func (c *NativeController) enqueueOwner(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	ref := metav1.GetControllerOf(m)
+	if ref == nil || ref.APIVersion != fmt.Sprintf("%s/%s", tfv1.Group, tfv1.Version) {
+		return
+	}
+	c.queue.Add(fmt.Sprintf("%s/%s/%s", ref.Kind, m.GetNamespace(), ref.Name))
+}
+
+func (c *NativeController) runWorker() {
+	for c.processNextItem() {
+	}
+}
+
+func (c *NativeController) processNextItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	start := time.Now()
+	err := c.syncHandler(key.(string))
+	observeSync(strings.SplitN(key.(string), "/", 2)[0], start, err)
+	if err != nil {
+		log.Printf("[ERROR] Failed to sync %s: %v", key, err)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *NativeController) syncHandler(key string) error {
+	toks := strings.SplitN(key, "/", 3)
+	if len(toks) != 3 {
+		return fmt.Errorf("Invalid key: %s", key)
+	}
+	kind, namespace, name := tfv1.TFKind(toks[0]), toks[1], toks[2]
+
+	parent, err := c.tfInformers.Get(kind, namespace, name)
+	if apierrors.IsNotFound(err) {
+		// Parent was deleted, children are garbage collected.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	children := c.getChildren(&parent)
+
+	var status *tfv1.TerraformOperatorStatus
+	var desiredChildren *[]interface{}
+	finalized := false
+
+	wantFinalizer := parent.GetTFKind() == tfv1.TFKindApply && parent.Spec != nil && parent.Spec.DestroyOnDelete
+
+	if parent.GetDeletionTimestamp() != nil {
+		if !hasFinalizer(&parent, nativeFinalizer) {
+			return nil
+		}
+		status, desiredChildren, finalized, err = finalize(getParentType(kind), &parent, &children)
+	} else {
+		if wantFinalizer != hasFinalizer(&parent, nativeFinalizer) {
+			// The update triggers the next sync.
+			return c.setFinalizer(&parent, wantFinalizer)
+		}
+		status, desiredChildren, err = sync(getParentType(kind), &parent, &children)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := c.applyChildren(&parent, desiredChildren); err != nil {
+		return err
+	}
+
+	if err := c.updateStatus(&parent, status); err != nil {
+		return err
+	}
+
+	if finalized {
+		return c.setFinalizer(&parent, false)
+	}
+
+	c.queue.AddAfter(key, c.resync)
+
+	return nil
+}
+
+// secretChanged returns true if the data, or a label or annotation of the desired Secret differs from the current Secret.
+func secretChanged(curr corev1.Secret, desired corev1.Secret) bool {
+	if !reflect.DeepEqual(curr.Data, desired.Data) {
+		return true
+	}
+	for k, v := range desired.GetLabels() {
+		if curr.GetLabels()[k] != v {
+			return true
+		}
+	}
+	for k, v := range desired.GetAnnotations() {
+		if curr.GetAnnotations()[k] != v {
+			return true
+		}
+	}
+	return false
+}
+
+// getChildren returns the children controlled by the parent in the same format as the CompositeController request.
+func (c *NativeController) getChildren(parent *tfv1.Terraform) TerraformChildren {
+	children := TerraformChildren{
+		Pods:       make(map[string]corev1.Pod, 0),
+		ConfigMaps: make(map[string]corev1.ConfigMap, 0),
+		Secrets:    make(map[string]corev1.Secret, 0),
+		Jobs:       make(map[string]batchv1.Job, 0),
+	}
+
+	uid := string(parent.GetUID())
+
+	pods, _ := c.pods.GetIndexer().ByIndex(ownerUIDIndex, uid)
+	for _, obj := range pods {
+		pod := obj.(*corev1.Pod)
+		children.Pods[pod.GetName()] = *pod.DeepCopy()
+	}
+
+	configMaps, _ := c.configMaps.GetIndexer().ByIndex(ownerUIDIndex, uid)
+	for _, obj := range configMaps {
+		cm := obj.(*corev1.ConfigMap)
+		children.ConfigMaps[cm.GetName()] = *cm.DeepCopy()
+	}
+
+	secrets, _ := c.secrets.GetIndexer().ByIndex(ownerUIDIndex, uid)
+	for _, obj := range secrets {
+		secret := obj.(*corev1.Secret)
+		children.Secrets[secret.GetName()] = *secret.DeepCopy()
+	}
+
+	jobs, _ := c.jobs.GetIndexer().ByIndex(ownerUIDIndex, uid)
+	for _, obj := range jobs {
+		job := obj.(*batchv1.Job)
+		children.Jobs[job.GetName()] = *job.DeepCopy()
+	}
+
+	return children
+}
+
+// applyChildren creates the desired children and deletes the children that are no longer desired.
+// Pods, Jobs and ConfigMaps are only created, matching the InPlace and OnDelete update strategies of the CompositeController.
+// Secrets are updated when their data changes.
+func (c *NativeController) applyChildren(parent *tfv1.Terraform, desiredChildren *[]interface{}) error {
+	existing := c.getChildren(parent)
+	desired := make(map[string]bool, 0)
+
+	ownerRef := metav1.OwnerReference{
+		APIVersion:         fmt.Sprintf("%s/%s", tfv1.Group, tfv1.Version),
+		Kind:               parent.Kind,
+		Name:               parent.GetName(),
+		UID:                parent.GetUID(),
+		Controller:         boolPtr(true),
+		BlockOwnerDeletion: boolPtr(true),
+	}
+
+	for _, child := range *desiredChildren {
+		u, err := toUnstructuredChild(child)
+		if err != nil {
+			return err
+		}
+		u.SetNamespace(parent.GetNamespace())
+		u.SetOwnerReferences([]metav1.OwnerReference{ownerRef})
+
+		labels := u.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string, 0)
+		}
+		labels[parentLabel] = parent.GetName()
+		u.SetLabels(labels)
+
+		name := u.GetName()
+		desired[fmt.Sprintf("%s/%s", u.GetKind(), name)] = true
+
+		gv, err := schema.ParseGroupVersion(u.GetAPIVersion())
+		if err != nil {
+			return err
+		}
+		client := c.dynClient.Resource(gv.WithResource(fmt.Sprintf("%ss", strings.ToLower(u.GetKind())))).Namespace(parent.GetNamespace())
+
+		switch u.GetKind() {
+		case "Pod":
+			if _, ok := existing.Pods[name]; ok {
+				continue
+			}
+		case "Job":
+			if _, ok := existing.Jobs[name]; ok {
+				continue
+			}
+		case "ConfigMap":
+			if _, ok := existing.ConfigMaps[name]; ok {
+				continue
+			}
+		case "Secret":
+			if curr, ok := existing.Secrets[name]; ok {
+				if !secretChanged(curr, child.(corev1.Secret)) {
+					continue
+				}
+				u.SetResourceVersion(curr.GetResourceVersion())
+				if _, err := client.Update(u, metav1.UpdateOptions{}); err != nil {
+					return err
+				}
+				parent.Log("INFO", "Updated Secret/%s", name)
+				continue
+			}
+		}
+
+		if _, err := client.Create(u, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	deleteOptions := &metav1.DeleteOptions{PropagationPolicy: &propagation}
+
+	for name := range existing.Pods {
+		if !desired["Pod/"+name] {
+			if err := c.clientset.CoreV1().Pods(parent.GetNamespace()).Delete(name, deleteOptions); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	for name := range existing.Jobs {
+		if !desired["Job/"+name] {
+			if err := c.clientset.BatchV1().Jobs(parent.GetNamespace()).Delete(name, deleteOptions); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	for name := range existing.ConfigMaps {
+		if !desired["ConfigMap/"+name] {
+			if err := c.clientset.CoreV1().ConfigMaps(parent.GetNamespace()).Delete(name, deleteOptions); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	for name := range existing.Secrets {
+		if !desired["Secret/"+name] {
+			if err := c.clientset.CoreV1().Secrets(parent.GetNamespace()).Delete(name, deleteOptions); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateStatus writes the status subresource of the parent.
+// The write is skipped when only the condition probe times changed.
+func (c *NativeController) updateStatus(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus) error {
+	equal, err := statusEqual(&parent.Status, status)
+	if err != nil {
+		return err
+	}
+	if equal {
+		return nil
+	}
+
+	kind := parent.GetTFKind()
+	client := c.dynClient.Resource(kind.GetGroupVersionResource()).Namespace(parent.GetNamespace())
+
+	u, err := client.Get(parent.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	statusMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return err
+	}
+	u.Object["status"] = statusMap
+
+	_, err = client.UpdateStatus(u, metav1.UpdateOptions{})
+	return err
+}
+
+// setFinalizer adds or removes the native finalizer on the parent.
+func (c *NativeController) setFinalizer(parent *tfv1.Terraform, add bool) error {
+	kind := parent.GetTFKind()
+	client := c.dynClient.Resource(kind.GetGroupVersionResource()).Namespace(parent.GetNamespace())
+
+	u, err := client.Get(parent.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	finalizers := make([]string, 0)
+	for _, f := range u.GetFinalizers() {
+		if f != nativeFinalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+	if add {
+		finalizers = append(finalizers, nativeFinalizer)
+	}
+	u.SetFinalizers(finalizers)
+
+	_, err = client.Update(u, metav1.UpdateOptions{})
+	return err
+}
+
+// statusEqual compares the statuses in their serialized form, ignoring the lastProbeTime of the conditions.
+func statusEqual(curr, desired *tfv1.TerraformOperatorStatus) (bool, error) {
+	a, err := statusWithoutProbeTimes(curr)
+	if err != nil {
+		return false, err
+	}
+	b, err := statusWithoutProbeTimes(desired)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(a, b), nil
+}
+
+func statusWithoutProbeTimes(status *tfv1.TerraformOperatorStatus) (map[string]interface{}, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return nil, err
+	}
+	conditions, _, _ := unstructured.NestedSlice(u, "conditions")
+	for _, c := range conditions {
+		if m, ok := c.(map[string]interface{}); ok {
+			delete(m, "lastProbeTime")
+		}
+	}
+	if conditions != nil {
+		u["conditions"] = conditions
+	}
+	return u, nil
+}
+
+func hasFinalizer(parent *tfv1.Terraform, finalizer string) bool {
+	for _, f := range parent.GetFinalizers() {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+func ownerUIDIndexFunc(obj interface{}) ([]string, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if ref := metav1.GetControllerOf(m); ref != nil {
+		return []string{string(ref.UID)}, nil
+	}
+	return []string{}, nil
+}
+
+// toUnstructuredChild converts a desired child to unstructured, the kind is set from the child type
+// because children claimed from the informer cache do not have the TypeMeta.
+func toUnstructuredChild(child interface{}) (*unstructured.Unstructured, error) {
+	apiVersion := "v1"
+	var kind string
+	switch child.(type) {
+	case Pod, corev1.Pod:
+		kind = "Pod"
+	case batchv1.Job:
+		apiVersion = "batch/v1"
+		kind = "Job"
+	case corev1.ConfigMap:
+		kind = "ConfigMap"
+	case corev1.Secret:
+		kind = "Secret"
+	default:
+		return nil, fmt.Errorf("Unsupported child type: %T", child)
+	}
+
+	data, err := json.Marshal(child)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, &u.Object); err != nil {
+		return nil, err
+	}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+
+	// Server populated fields from claimed children are not sent on create.
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(u.Object, "metadata", "uid")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+
+	return u, nil
+}
+
+func getParentType(kind tfv1.TFKind) ParentType {
+	switch kind {
+	case tfv1.TFKindPlan:
+		return ParentPlan
+	case tfv1.TFKindApply:
+		return ParentApply
+	case tfv1.TFKindDestroy:
+		return ParentDestroy
+	}
+	return ""
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
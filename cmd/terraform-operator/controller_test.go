@@ -0,0 +1,357 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+func makeTestParent(name string, uid string) tfv1.Terraform {
+	parent := makeTestTerraform(tfv1.TFKindApply, name)
+	parent.TypeMeta.APIVersion = tfv1.Group + "/" + tfv1.Version
+	parent.SetUID(types.UID(uid))
+	parent.SetGeneration(1)
+	return parent
+}
+
+func makeTestOwnerRef(parent tfv1.Terraform) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{
+		APIVersion: parent.APIVersion,
+		Kind:       parent.Kind,
+		Name:       parent.GetName(),
+		UID:        parent.GetUID(),
+		Controller: boolPtr(true),
+	}}
+}
+
+// newTestNativeController returns a controller with the parents in the Terraform caches and the dynamic client,
+// and the child objects in the clientset. The child informers are started and synced until the stop channel is closed.
+func newTestNativeController(t *testing.T, stopCh chan struct{}, parents []tfv1.Terraform, children ...runtime.Object) (*NativeController, *dynamicfake.FakeDynamicClient, *kubefake.Clientset) {
+	clientset := kubefake.NewSimpleClientset(children...)
+	dynClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	tfInformers := &TerraformInformers{
+		informers: make(map[tfv1.TFKind]cache.SharedIndexInformer, 0),
+		listers:   make(map[tfv1.TFKind]cache.GenericLister, 0),
+	}
+	for _, kind := range []tfv1.TFKind{tfv1.TFKindPlan, tfv1.TFKindApply, tfv1.TFKindDestroy} {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		for _, parent := range parents {
+			if parent.GetTFKind() != kind {
+				continue
+			}
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&parent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			u := &unstructured.Unstructured{Object: obj}
+			indexer.Add(u)
+
+			// The fake object tracker guesses the plural from the kind, create through the client instead.
+			if _, err := dynClient.Resource(kind.GetGroupVersionResource()).Namespace(u.GetNamespace()).Create(u, metav1.CreateOptions{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		tfInformers.listers[kind] = cache.NewGenericLister(indexer, kind.GetGroupVersionResource().GroupResource())
+	}
+
+	c := newNativeController(clientset, dynClient, tfInformers, time.Minute)
+
+	c.factory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, c.pods.HasSynced, c.configMaps.HasSynced, c.secrets.HasSynced, c.jobs.HasSynced) {
+		t.Fatal("Timed out waiting for child caches to sync")
+	}
+
+	dynClient.ClearActions()
+
+	return c, dynClient, clientset
+}
+
+func filterActions(actions []k8stesting.Action, verb string, resource string) []k8stesting.Action {
+	filtered := make([]k8stesting.Action, 0)
+	for _, a := range actions {
+		if a.GetVerb() == verb && a.GetResource().Resource == resource {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
+
+func TestSyncHandler(t *testing.T) {
+	parent := makeTestParent("test", "uid-1")
+
+	deleting := makeTestParent("deleting", "uid-2")
+	deleting.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+
+	destroyOnDelete := makeTestParent("destroy-on-delete", "uid-3")
+	destroyOnDelete.Spec.DestroyOnDelete = true
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c, dynClient, _ := newTestNativeController(t, stopCh, []tfv1.Terraform{parent, deleting, destroyOnDelete})
+
+	tests := []struct {
+		name           string
+		key            string
+		expectErr      bool
+		expectUpdates  int
+		expectFinalize []string
+	}{
+		{"invalid key", "TerraformApply/test", true, 0, nil},
+		{"not found", "TerraformApply/default/missing", false, 0, nil},
+		{"deleting without finalizer", "TerraformApply/default/deleting", false, 0, nil},
+		{"add finalizer", "TerraformApply/default/destroy-on-delete", false, 1, []string{nativeFinalizer}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynClient.ClearActions()
+
+			err := c.syncHandler(tc.key)
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("\n\texp err: %v\n\n\tgot: %v", tc.expectErr, err)
+			}
+
+			updates := filterActions(dynClient.Actions(), "update", "terraformapplys")
+			if len(updates) != tc.expectUpdates {
+				t.Fatalf("\n\texp: %#v\n\n\tgot: %#v", tc.expectUpdates, len(updates))
+			}
+			if tc.expectFinalize != nil {
+				u := updates[0].(k8stesting.UpdateAction).GetObject().(*unstructured.Unstructured)
+				if !reflect.DeepEqual(u.GetFinalizers(), tc.expectFinalize) {
+					t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expectFinalize, u.GetFinalizers())
+				}
+			}
+		})
+	}
+}
+
+func TestGetChildren(t *testing.T) {
+	parent := makeTestParent("test", "uid-1")
+	other := makeTestParent("other", "uid-2")
+
+	children := []runtime.Object{
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: "default", Labels: map[string]string{parentLabel: "test"}, OwnerReferences: makeTestOwnerRef(parent)}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", Labels: map[string]string{parentLabel: "other"}, OwnerReferences: makeTestOwnerRef(other)}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: "default", Labels: map[string]string{parentLabel: "test"}, OwnerReferences: makeTestOwnerRef(parent)}},
+		// Children without the parent label are not watched.
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", Namespace: "default", OwnerReferences: makeTestOwnerRef(parent)}},
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c, _, _ := newTestNativeController(t, stopCh, []tfv1.Terraform{parent, other}, children...)
+
+	got := c.getChildren(&parent)
+
+	if _, ok := got.Pods["owned"]; !ok || len(got.Pods) != 1 {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", []string{"owned"}, got.Pods)
+	}
+	if _, ok := got.ConfigMaps["owned"]; !ok || len(got.ConfigMaps) != 1 {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", []string{"owned"}, got.ConfigMaps)
+	}
+	if len(got.Secrets) != 0 {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", []string{}, got.Secrets)
+	}
+	if len(got.Jobs) != 0 {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", []string{}, got.Jobs)
+	}
+}
+
+func TestApplyChildren(t *testing.T) {
+	parent := makeTestParent("test", "uid-1")
+
+	labels := map[string]string{parentLabel: "test"}
+	existing := []runtime.Object{
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "undesired", Namespace: "default", Labels: labels, OwnerReferences: makeTestOwnerRef(parent)}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unchanged", Namespace: "default", Labels: labels, OwnerReferences: makeTestOwnerRef(parent)}, Data: map[string][]byte{"a": []byte("1")}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "changed", Namespace: "default", Labels: labels, OwnerReferences: makeTestOwnerRef(parent)}, Data: map[string][]byte{"a": []byte("1")}},
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c, dynClient, clientset := newTestNativeController(t, stopCh, []tfv1.Terraform{parent}, existing...)
+
+	// Secrets are updated through the dynamic client.
+	changed, err := toUnstructuredChild(*existing[2].(*corev1.Secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dynClient.Resource(corev1.SchemeGroupVersion.WithResource("secrets")).Namespace("default").Create(changed, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	dynClient.ClearActions()
+	clientset.ClearActions()
+
+	desiredChildren := []interface{}{
+		corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "new"}, Data: map[string]string{"main.tf": ""}},
+		corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unchanged"}, Data: map[string][]byte{"a": []byte("1")}},
+		corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "changed"}, Data: map[string][]byte{"a": []byte("2")}},
+	}
+
+	if err := c.applyChildren(&parent, &desiredChildren); err != nil {
+		t.Fatal(err)
+	}
+
+	creates := filterActions(dynClient.Actions(), "create", "configmaps")
+	if len(creates) != 1 {
+		t.Fatalf("\n\texp: %#v\n\n\tgot: %#v", 1, len(creates))
+	}
+	cm := creates[0].(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+	if cm.GetLabels()[parentLabel] != "test" {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", labels, cm.GetLabels())
+	}
+	if ref := metav1.GetControllerOf(cm); ref == nil || ref.UID != parent.GetUID() {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", parent.GetUID(), ref)
+	}
+
+	updates := filterActions(dynClient.Actions(), "update", "secrets")
+	if len(updates) != 1 {
+		t.Fatalf("\n\texp: %#v\n\n\tgot: %#v", 1, len(updates))
+	}
+	if name := updates[0].(k8stesting.UpdateAction).GetObject().(*unstructured.Unstructured).GetName(); name != "changed" {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", "changed", name)
+	}
+
+	deletes := filterActions(clientset.Actions(), "delete", "pods")
+	if len(deletes) != 1 {
+		t.Fatalf("\n\texp: %#v\n\n\tgot: %#v", 1, len(deletes))
+	}
+	if name := deletes[0].(k8stesting.DeleteAction).GetName(); name != "undesired" {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", "undesired", name)
+	}
+}
+
+func TestSetFinalizer(t *testing.T) {
+	parent := makeTestParent("test", "uid-1")
+	parent.SetFinalizers([]string{"other"})
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c, dynClient, _ := newTestNativeController(t, stopCh, []tfv1.Terraform{parent})
+	kind := parent.GetTFKind()
+	client := dynClient.Resource(kind.GetGroupVersionResource()).Namespace("default")
+
+	tests := []struct {
+		name     string
+		add      bool
+		expected []string
+	}{
+		{"add", true, []string{"other", nativeFinalizer}},
+		{"add again", true, []string{"other", nativeFinalizer}},
+		{"remove", false, []string{"other"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := c.setFinalizer(&parent, tc.add); err != nil {
+				t.Fatal(err)
+			}
+			u, err := client.Get("test", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(u.GetFinalizers(), tc.expected) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, u.GetFinalizers())
+			}
+		})
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	tProbe := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	tNow := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	parent := makeTestParent("test", "uid-1")
+	parent.Status = tfv1.TerraformOperatorStatus{
+		PodStatus: tfv1.PodStatusRunning,
+		Conditions: []tfv1.Condition{
+			{Type: tfv1.ConditionReady, Status: tfv1.ConditionFalse, LastProbeTime: tProbe, LastTransitionTime: tProbe},
+		},
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c, dynClient, _ := newTestNativeController(t, stopCh, []tfv1.Terraform{parent})
+
+	probed := parent.Status
+	probed.Conditions = []tfv1.Condition{
+		{Type: tfv1.ConditionReady, Status: tfv1.ConditionFalse, LastProbeTime: tNow, LastTransitionTime: tProbe},
+	}
+
+	passed := probed
+	passed.PodStatus = tfv1.PodStatusPassed
+
+	tests := []struct {
+		name          string
+		status        tfv1.TerraformOperatorStatus
+		expectUpdates int
+	}{
+		{"unchanged", parent.Status, 0},
+		{"probe time only", probed, 0},
+		{"changed", passed, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynClient.ClearActions()
+
+			if err := c.updateStatus(&parent, &tc.status); err != nil {
+				t.Fatal(err)
+			}
+
+			updates := filterActions(dynClient.Actions(), "update", "terraformapplys")
+			if len(updates) != tc.expectUpdates {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expectUpdates, len(updates))
+			}
+		})
+	}
+}
+
+func TestParentChanged(t *testing.T) {
+	base := makeTestParent("test", "uid-1")
+
+	statusOnly := base
+	statusOnly.Status.PodStatus = tfv1.PodStatusRunning
+
+	generation := base
+	generation.SetGeneration(2)
+
+	annotated := base
+	annotated.SetAnnotations(map[string]string{tfv1.AnnotationApprovedPlanHash: "abc"})
+
+	finalized := base
+	finalized.SetFinalizers([]string{nativeFinalizer})
+
+	tests := []struct {
+		name     string
+		obj      tfv1.Terraform
+		expected bool
+	}{
+		{"status only", statusOnly, false},
+		{"generation", generation, true},
+		{"annotations", annotated, true},
+		{"finalizers", finalized, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := parentChanged(&base, &tc.obj)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+// jsonPatchOp is a JSON patch operation returned by the mutating admission webhook.
+type jsonPatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// getSpecDefaultsPatch returns the patch that sets the operator defaults for the fields not given in the spec,
+// so that the spec records the image, backend and attempts used by the runs even if the operator config changes.
+func getSpecDefaultsPatch(spec *tfv1.TerraformSpec) []jsonPatchOp {
+	patch := make([]jsonPatchOp, 0)
+
+	if spec.Image == "" && tfDriverConfig.Image != "" {
+		patch = append(patch, jsonPatchOp{"add", "/spec/image", tfDriverConfig.Image})
+	}
+
+	if spec.ImagePullPolicy == "" && tfDriverConfig.ImagePullPolicy != "" {
+		patch = append(patch, jsonPatchOp{"add", "/spec/imagePullPolicy", tfDriverConfig.ImagePullPolicy})
+	}
+
+	// The bucket and prefix only apply to the GCS backend and are not set when given in the backend config.
+	if spec.Backend == nil || spec.Backend.Type == tfv1.BackendGCS {
+		var config map[string]string
+		if spec.Backend != nil {
+			config = spec.Backend.Config
+		}
+		if _, ok := config["bucket"]; !ok && spec.BackendBucket == "" {
+			patch = append(patch, jsonPatchOp{"add", "/spec/backendBucket", tfDriverConfig.BackendBucket})
+		}
+		if _, ok := config["prefix"]; !ok && spec.BackendPrefix == "" {
+			patch = append(patch, jsonPatchOp{"add", "/spec/backendPrefix", tfDriverConfig.BackendPrefix})
+		}
+	}
+
+	// Retries of Jobs use the backoffLimit.
+	if spec.MaxAttempts == nil && spec.Job == nil && (spec.RetryPolicy == nil || spec.RetryPolicy.MaxAttempts == nil) {
+		patch = append(patch, jsonPatchOp{"add", "/spec/maxAttempts", tfDriverConfig.MaxAttempts})
+	}
+
+	return patch
+}
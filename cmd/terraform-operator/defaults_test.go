@@ -0,0 +1,82 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	tfdriverv1 "github.com/danisla/terraform-operator/pkg/tfdriver"
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetSpecDefaultsPatch(t *testing.T) {
+	tfDriverConfig = tfdriverv1.TerraformDriverConfig{
+		Image:           "gcr.io/cloud-solutions-group/terraform-pod:v0.11.8",
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		BackendBucket:   "my-project-terraform-operator",
+		BackendPrefix:   "terraform",
+		MaxAttempts:     4,
+	}
+
+	maxAttempts := int32(2)
+
+	tests := []struct {
+		name     string
+		spec     tfv1.TerraformSpec
+		expected []jsonPatchOp
+	}{
+		{
+			"all defaults",
+			tfv1.TerraformSpec{},
+			[]jsonPatchOp{
+				{"add", "/spec/image", "gcr.io/cloud-solutions-group/terraform-pod:v0.11.8"},
+				{"add", "/spec/imagePullPolicy", corev1.PullIfNotPresent},
+				{"add", "/spec/backendBucket", "my-project-terraform-operator"},
+				{"add", "/spec/backendPrefix", "terraform"},
+				{"add", "/spec/maxAttempts", int32(4)},
+			},
+		},
+		{
+			"given fields",
+			tfv1.TerraformSpec{
+				Image:           "terraform-pod:dev",
+				ImagePullPolicy: corev1.PullAlways,
+				BackendBucket:   "bucket",
+				BackendPrefix:   "prefix",
+				MaxAttempts:     &maxAttempts,
+			},
+			[]jsonPatchOp{},
+		},
+		{
+			"non gcs backend with job",
+			tfv1.TerraformSpec{
+				Image:           "terraform-pod:dev",
+				ImagePullPolicy: corev1.PullAlways,
+				Backend:         &tfv1.TerraformBackend{Type: tfv1.BackendKubernetes},
+				Job:             &tfv1.TerraformJob{},
+			},
+			[]jsonPatchOp{},
+		},
+		{
+			"gcs backend config",
+			tfv1.TerraformSpec{
+				Image:           "terraform-pod:dev",
+				ImagePullPolicy: corev1.PullAlways,
+				Backend:         &tfv1.TerraformBackend{Type: tfv1.BackendGCS, Config: map[string]string{"bucket": "bucket"}},
+				MaxAttempts:     &maxAttempts,
+			},
+			[]jsonPatchOp{
+				{"add", "/spec/backendPrefix", "terraform"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := getSpecDefaultsPatch(&tc.spec)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Reasons of the Events emitted on the Terraform resources.
+const (
+	EventReasonStarted   = "Started"
+	EventReasonSucceeded = "Succeeded"
+	EventReasonFailed    = "Failed"
+	EventReasonRetrying  = "Retrying"
+
+	EventReasonDestroySkipped = "DestroySkipped"
+	EventReasonDestroyFailed  = "DestroyFailed"
+)
+
+// formatEventRunReason returns the reason a run was started for the Event message.
+func formatEventRunReason(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	return fmt.Sprintf(": %s", reason)
+}
+
+// recordEvent records an Event on the parent with the event recorder.
+// Callers only record transitions, repeated Events are aggregated by the recorder.
+func recordEvent(parent *tfv1.Terraform, eventType string, reason string, messageFmt string, args ...interface{}) {
+	if config.recorder == nil {
+		return
+	}
+
+	ref := &corev1.ObjectReference{
+		APIVersion:      fmt.Sprintf("%s/%s", tfv1.Group, tfv1.Version),
+		Kind:            parent.Kind,
+		Name:            parent.GetName(),
+		Namespace:       parent.GetNamespace(),
+		UID:             parent.GetUID(),
+		ResourceVersion: parent.GetResourceVersion(),
+	}
+
+	config.recorder.Eventf(ref, eventType, reason, messageFmt, args...)
+}
+
+// recordConditionEvent records the transition of a condition to the new status with the condition reason.
+func recordConditionEvent(parent *tfv1.Terraform, condition *tfv1.Condition, newStatus tfv1.ConditionStatus) {
+	eventType := getConditionEventType(condition, newStatus)
+
+	message := fmt.Sprintf("%s is %s", condition.Type, newStatus)
+	if condition.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, condition.Reason)
+	}
+
+	recordEvent(parent, eventType, string(condition.Type), "%s", message)
+}
+
+// isPodStatusTransition returns true if the run finished since the last sync, the previous status was Running or not set.
+// The Succeeded and Failed Events are only recorded on this transition.
+func isPodStatusTransition(prev tfv1.PodStatus) bool {
+	return prev == "" || prev == tfv1.PodStatusRunning
+}
+
+// getConditionEventType returns Warning for conditions becoming False after they were known, and for detected drift.
+func getConditionEventType(condition *tfv1.Condition, newStatus tfv1.ConditionStatus) string {
+	if condition.Type == tfv1.ConditionDrifted {
+		if newStatus == tfv1.ConditionTrue {
+			return corev1.EventTypeWarning
+		}
+		return corev1.EventTypeNormal
+	}
+	if newStatus == tfv1.ConditionFalse && condition.Status != tfv1.ConditionUnknown {
+		return corev1.EventTypeWarning
+	}
+	return corev1.EventTypeNormal
+}
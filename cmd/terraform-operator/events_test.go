@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetConditionEventType(t *testing.T) {
+	tests := []struct {
+		name          string
+		conditionType tfv1.ConditionType
+		oldStatus     tfv1.ConditionStatus
+		newStatus     tfv1.ConditionStatus
+		expected      string
+	}{
+		{"initial false", tfv1.ConditionPodComplete, tfv1.ConditionUnknown, tfv1.ConditionFalse, corev1.EventTypeNormal},
+		{"completed", tfv1.ConditionPodComplete, tfv1.ConditionFalse, tfv1.ConditionTrue, corev1.EventTypeNormal},
+		{"regressed", tfv1.ConditionReady, tfv1.ConditionTrue, tfv1.ConditionFalse, corev1.EventTypeWarning},
+		{"drift detected", tfv1.ConditionDrifted, tfv1.ConditionFalse, tfv1.ConditionTrue, corev1.EventTypeWarning},
+		{"drift converged", tfv1.ConditionDrifted, tfv1.ConditionTrue, tfv1.ConditionFalse, corev1.EventTypeNormal},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			condition := &tfv1.Condition{Type: tc.conditionType, Status: tc.oldStatus}
+			got := getConditionEventType(condition, tc.newStatus)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsPodStatusTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		prev     tfv1.PodStatus
+		expected bool
+	}{
+		{"not set", "", true},
+		{"running", tfv1.PodStatusRunning, true},
+		{"already passed", tfv1.PodStatusPassed, false},
+		{"already failed", tfv1.PodStatusFailed, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := isPodStatusTransition(tc.prev)
+			if got != tc.expected {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// finalize is called while the parent is being deleted. When spec.destroyOnDelete is set on a TerraformApply, the parent
+// is reconciled as a TerraformDestroy against the same workspace and backend until the destroy pod completes.
+// The returned finalized value is true when the finalizer can be removed.
+// The destroy is skipped with the skip annotation, a destroy that failed without a pending retry also removes the finalizer.
+func finalize(parentType ParentType, parent *tfv1.Terraform, children *TerraformChildren) (*tfv1.TerraformOperatorStatus, *[]interface{}, bool, error) {
+	if parent.GetTFKind() != tfv1.TFKindApply || parent.Spec == nil || parent.Spec.DestroyOnDelete == false {
+		return &parent.Status, &[]interface{}{}, true, nil
+	}
+
+	if parent.GetAnnotations()[tfv1.AnnotationSkipDestroyOnDelete] == "true" {
+		parent.Log("WARN", "Skipping destroy on delete")
+		recordEvent(parent, corev1.EventTypeWarning, EventReasonDestroySkipped, "Destroy on delete skipped with the %s annotation, the resources were not destroyed", tfv1.AnnotationSkipDestroyOnDelete)
+		return &parent.Status, &[]interface{}{}, true, nil
+	}
+
+	destroy := makeDestroyOnDeleteParent(parent)
+
+	// Only the destroy pods are considered, the apply pods are removed.
+	destroyPodName := regexp.MustCompile(fmt.Sprintf(`^%s-%s-[0-9]+$`, regexp.QuoteMeta(destroy.GetName()), destroy.GetTFKindShort()))
+	destroyPods := make(map[string]corev1.Pod, 0)
+	for name, pod := range children.Pods {
+		if destroyPodName.MatchString(name) {
+			destroyPods[name] = pod
+		}
+	}
+	children.Pods = destroyPods
+
+	destroyJobs := make(map[string]batchv1.Job, 0)
+	for name, job := range children.Jobs {
+		if destroyPodName.MatchString(name) {
+			destroyJobs[name] = job
+		}
+	}
+	children.Jobs = destroyJobs
+
+	// Start from a clean status when the destroy starts.
+	if len(destroyPods) == 0 && len(destroyJobs) == 0 && !destroyPodName.MatchString(parent.Status.PodName) {
+		parent.Log("INFO", "Destroying on delete")
+		destroy.Status = tfv1.TerraformOperatorStatus{}
+	}
+
+	status, desiredChildren, err := sync(ParentDestroy, destroy, children)
+	if err != nil {
+		return status, desiredChildren, false, err
+	}
+
+	finalized, failed := isDestroyOnDeleteFinalized(status)
+	if failed {
+		parent.Log("WARN", "Destroy on delete failed, removing finalizer")
+		recordEvent(parent, corev1.EventTypeWarning, EventReasonDestroyFailed, "Destroy on delete failed and is not retried, the resources may not be destroyed: Pod/%s", status.PodName)
+	} else if finalized {
+		parent.Log("INFO", "Destroy on delete complete")
+	}
+
+	return status, desiredChildren, finalized, nil
+}
+
+// isDestroyOnDeleteFinalized returns true if the finalizer can be removed after the destroy status.
+// The destroy is done when the pod completed, or when it failed and no retry is pending because the attempts are exhausted
+// or the error is not retryable. The failed value is true in the latter case.
+func isDestroyOnDeleteFinalized(status *tfv1.TerraformOperatorStatus) (finalized bool, failed bool) {
+	switch status.PodStatus {
+	case tfv1.PodStatusPassed:
+		for _, c := range status.Conditions {
+			if c.Type == tfv1.ConditionPodComplete && c.Status == tfv1.ConditionTrue {
+				return true, false
+			}
+		}
+	case tfv1.PodStatusFailed:
+		if status.RetryNextAt == "" {
+			return true, true
+		}
+	}
+	return false, false
+}
+
+// makeDestroyOnDeleteParent returns a copy of the parent as a TerraformDestroy.
+// Fields that only apply to the TerraformApply lifecycle are removed from the spec.
+func makeDestroyOnDeleteParent(parent *tfv1.Terraform) *tfv1.Terraform {
+	spec := *parent.Spec
+	spec.TFPlan = ""
+	spec.Approval = nil
+	spec.Policies = nil
+	spec.Schedule = ""
+	spec.DriftDetection = nil
+	spec.DestroyOnDelete = false
+
+	destroy := *parent
+	destroy.Kind = string(tfv1.TFKindDestroy)
+	destroy.Spec = &spec
+
+	return &destroy
+}
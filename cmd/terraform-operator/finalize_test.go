@@ -0,0 +1,96 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestMakeDestroyOnDeleteParent(t *testing.T) {
+	parent := makeTestTerraform(tfv1.TFKindApply, "test")
+	parent.Spec.TFPlan = "test"
+	parent.Spec.Approval = &tfv1.TerraformApproval{Required: true}
+	parent.Spec.Policies = []tfv1.TerraformPolicySource{{ConfigMap: "policies"}}
+	parent.Spec.Schedule = "@hourly"
+	parent.Spec.DriftDetection = &tfv1.TerraformDriftDetection{Interval: "12h"}
+	parent.Spec.DestroyOnDelete = true
+	parent.Spec.TFVars = &[]tfv1.TFVar{{Name: "region", Value: "us-central1"}}
+
+	destroy := makeDestroyOnDeleteParent(&parent)
+
+	if got := destroy.GetTFKind(); got != tfv1.TFKindDestroy {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tfv1.TFKindDestroy, got)
+	}
+
+	expected := tfv1.TerraformSpec{
+		ProviderConfig: parent.Spec.ProviderConfig,
+		Sources:        parent.Spec.Sources,
+		TFVars:         parent.Spec.TFVars,
+	}
+	if !reflect.DeepEqual(*destroy.Spec, expected) {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", expected, *destroy.Spec)
+	}
+
+	// The parent is not modified.
+	if parent.GetTFKind() != tfv1.TFKindApply || parent.Spec.TFPlan != "test" || !parent.Spec.DestroyOnDelete {
+		t.Errorf("parent spec was modified: %#v", parent.Spec)
+	}
+}
+
+func TestIsDestroyOnDeleteFinalized(t *testing.T) {
+	completed := []tfv1.Condition{{Type: tfv1.ConditionPodComplete, Status: tfv1.ConditionTrue}}
+
+	tests := []struct {
+		name      string
+		status    tfv1.TerraformOperatorStatus
+		finalized bool
+		failed    bool
+	}{
+		{"not started", tfv1.TerraformOperatorStatus{}, false, false},
+		{"running", tfv1.TerraformOperatorStatus{PodStatus: tfv1.PodStatusRunning}, false, false},
+		{"passed", tfv1.TerraformOperatorStatus{PodStatus: tfv1.PodStatusPassed, Conditions: completed}, true, false},
+		{"passed before complete", tfv1.TerraformOperatorStatus{PodStatus: tfv1.PodStatusPassed}, false, false},
+		{"retry pending", tfv1.TerraformOperatorStatus{PodStatus: tfv1.PodStatusFailed, RetryNextAt: "2018-10-01T00:00:00Z"}, false, false},
+		{"retries exhausted", tfv1.TerraformOperatorStatus{PodStatus: tfv1.PodStatusFailed}, true, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			finalized, failed := isDestroyOnDeleteFinalized(&tc.status)
+			if finalized != tc.finalized || failed != tc.failed {
+				t.Errorf("\n\texp: %#v, %#v\n\n\tgot: %#v, %#v", tc.finalized, tc.failed, finalized, failed)
+			}
+		})
+	}
+}
+
+func TestFinalizeSkipDestroy(t *testing.T) {
+	defer func(recorder record.EventRecorder) { config.recorder = recorder }(config.recorder)
+	recorder := record.NewFakeRecorder(10)
+	config.recorder = recorder
+
+	parent := makeTestTerraform(tfv1.TFKindApply, "test")
+	parent.Spec.DestroyOnDelete = true
+	parent.SetAnnotations(map[string]string{tfv1.AnnotationSkipDestroyOnDelete: "true"})
+
+	_, desiredChildren, finalized, err := finalize(ParentApply, &parent, &TerraformChildren{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !finalized {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", true, finalized)
+	}
+	if len(*desiredChildren) != 0 {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", 0, len(*desiredChildren))
+	}
+	if len(recorder.Events) != 1 {
+		t.Fatalf("\n\texp: %#v\n\n\tgot: %#v", 1, len(recorder.Events))
+	}
+	if event := <-recorder.Events; event[:len(corev1.EventTypeWarning)] != corev1.EventTypeWarning {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", corev1.EventTypeWarning, event)
+	}
+}
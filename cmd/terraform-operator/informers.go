@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// outputsSecretLabel is set on the outputs Secrets of the TerraformApply resources, only these Secrets are cached.
+const outputsSecretLabel = "terraform-outputs"
+
+// TerraformInformers caches the Terraform custom resources so that cross-resource lookups are served from memory.
+// The outputs Secrets are cached for the tfinputs of other resources.
+type TerraformInformers struct {
+	factory   dynamicinformer.DynamicSharedInformerFactory
+	informers map[tfv1.TFKind]cache.SharedIndexInformer
+	listers   map[tfv1.TFKind]cache.GenericLister
+
+	secretsFactory  informers.SharedInformerFactory
+	secretsInformer cache.SharedIndexInformer
+	secretsLister   corelisters.SecretLister
+}
+
+func newTerraformInformers(client dynamic.Interface, clientset kubernetes.Interface, resync time.Duration) *TerraformInformers {
+	t := &TerraformInformers{
+		factory:   dynamicinformer.NewDynamicSharedInformerFactory(client, resync),
+		informers: make(map[tfv1.TFKind]cache.SharedIndexInformer, 0),
+		listers:   make(map[tfv1.TFKind]cache.GenericLister, 0),
+		secretsFactory: informers.NewFilteredSharedInformerFactory(clientset, resync, metav1.NamespaceAll, func(opts *metav1.ListOptions) {
+			opts.LabelSelector = outputsSecretLabel
+		}),
+	}
+
+	for _, kind := range []tfv1.TFKind{tfv1.TFKindPlan, tfv1.TFKindApply, tfv1.TFKindDestroy} {
+		informer := t.factory.ForResource(kind.GetGroupVersionResource())
+		t.informers[kind] = informer.Informer()
+		t.listers[kind] = informer.Lister()
+	}
+
+	secrets := t.secretsFactory.Core().V1().Secrets()
+	t.secretsInformer = secrets.Informer()
+	t.secretsLister = secrets.Lister()
+
+	return t
+}
+
+// Start runs the informers and blocks until the initial list of each kind has been cached.
+func (t *TerraformInformers) Start(stopCh <-chan struct{}) error {
+	t.factory.Start(stopCh)
+	t.secretsFactory.Start(stopCh)
+
+	for kind, informer := range t.informers {
+		if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+			return fmt.Errorf("Timed out waiting for %s cache to sync", kind)
+		}
+	}
+
+	if !cache.WaitForCacheSync(stopCh, t.secretsInformer.HasSynced) {
+		return fmt.Errorf("Timed out waiting for outputs Secret cache to sync")
+	}
+
+	return nil
+}
+
+// GetOutputsSecret returns the cached outputs Secret, the returned Secret must not be modified.
+func (t *TerraformInformers) GetOutputsSecret(namespace, name string) (*corev1.Secret, error) {
+	if t.secretsLister == nil {
+		return nil, fmt.Errorf("Outputs Secret cache is not configured")
+	}
+	return t.secretsLister.Secrets(namespace).Get(name)
+}
+
+// List returns all cached Terraform objects of the given kind.
+func (t *TerraformInformers) List(kind tfv1.TFKind) ([]tfv1.Terraform, error) {
+	lister, ok := t.listers[kind]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported kind: %s", kind)
+	}
+
+	objs, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	tfs := make([]tfv1.Terraform, 0)
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("Unexpected object type in %s cache: %T", kind, obj)
+		}
+		var tf tfv1.Terraform
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &tf); err != nil {
+			return nil, err
+		}
+		tfs = append(tfs, tf)
+	}
+
+	return tfs, nil
+}
+
+// Get returns the cached Terraform object of the given kind.
+func (t *TerraformInformers) Get(kind tfv1.TFKind, namespace, name string) (tfv1.Terraform, error) {
+	var tf tfv1.Terraform
+
+	lister, ok := t.listers[kind]
+	if !ok {
+		return tf, fmt.Errorf("Unsupported kind: %s", kind)
+	}
+
+	obj, err := lister.ByNamespace(namespace).Get(name)
+	if err != nil {
+		return tf, err
+	}
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return tf, fmt.Errorf("Unexpected object type in %s cache: %T", kind, obj)
+	}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &tf)
+
+	return tf, err
+}
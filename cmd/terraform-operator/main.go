@@ -7,17 +7,23 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"os"
+	"time"
 
 	tfdriverv1 "github.com/danisla/terraform-operator/pkg/tfdriver"
 	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 var (
 	config         Config
 	tfDriverConfig tfdriverv1.TerraformDriverConfig
+	tfInformers    *TerraformInformers
 )
 
-func init() {
+// loadConfig reads the config from the metadata server and the environment.
+// It is called from main instead of init so that the package tests run without a cluster or the metadata server.
+func loadConfig() {
 	config = Config{
 		Project:    "", // Derived from instance metadata server
 		ProjectNum: "", // Derived from instance metadata server
@@ -32,16 +38,60 @@ func init() {
 	if err := tfDriverConfig.LoadAndValidate(config.Project); err != nil {
 		log.Fatalf("Failed to load terraform driver config: %v", err)
 	}
+
+	tfInformers = newTerraformInformers(config.dynClient, config.clientset, 0)
 }
 
 func main() {
+	loadConfig()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	if err := tfInformers.Start(stopCh); err != nil {
+		log.Fatalf("Failed to start informers: %v", err)
+	}
+
 	http.HandleFunc("/healthz", healthzHandler())
-	http.HandleFunc("/", webhookHandler())
 
-	log.Printf("[INFO] Initialized controller on port 80\n")
+	prometheus.MustRegister(newTerraformCollector(tfInformers))
+	http.Handle("/metrics", promhttp.Handler())
+
+	switch config.ControllerMode {
+	case ControllerModeNative:
+		controller := newNativeController(config.clientset, config.dynClient, tfInformers, nativeResyncPeriod)
+		go func() {
+			if err := controller.Run(nativeWorkers, stopCh); err != nil {
+				log.Fatalf("Failed to run native controller: %v", err)
+			}
+		}()
+	default:
+		http.HandleFunc("/", webhookHandler())
+	}
+
+	if config.AdmissionCert != "" {
+		if _, err := os.Stat(config.AdmissionCert); err != nil {
+			log.Printf("[INFO] Admission webhooks disabled, certificate not found: %v", err)
+		} else {
+			go serveAdmission()
+		}
+	}
+
+	log.Printf("[INFO] Initialized %s controller on port 80\n", config.ControllerMode)
 	log.Fatal(http.ListenAndServe(":80", nil))
 }
 
+// serveAdmission serves the admission webhooks over TLS on port 443.
+func serveAdmission() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", healthzHandler())
+	mux.HandleFunc("/validate", admissionHandler(validateAdmission))
+	mux.HandleFunc("/mutate", admissionHandler(defaultAdmission))
+
+	log.Printf("[INFO] Serving admission webhooks on port 443")
+	log.Fatal(http.ListenAndServeTLS(":443", config.AdmissionCert, config.AdmissionKey, mux))
+}
+
 func healthzHandler() func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "OK\n")
@@ -55,6 +105,7 @@ func webhookHandler() func(w http.ResponseWriter, r *http.Request) {
 		var desiredStatus *tfv1.TerraformOperatorStatus
 		var desiredChildren *[]interface{}
 		var parentType ParentType
+		var finalized bool
 
 		if r.Method != "POST" {
 			w.WriteHeader(http.StatusBadRequest)
@@ -75,15 +126,14 @@ func webhookHandler() func(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		switch tfv1.TFKind(req.Parent.Kind) {
-		case tfv1.TFKindPlan:
-			parentType = ParentPlan
-		case tfv1.TFKindApply:
-			parentType = ParentApply
-		case tfv1.TFKindDestroy:
-			parentType = ParentDestroy
+		start := time.Now()
+		parentType = getParentType(tfv1.TFKind(req.Parent.Kind))
+		if req.Finalizing {
+			desiredStatus, desiredChildren, finalized, err = finalize(parentType, &req.Parent, &req.Children)
+		} else {
+			desiredStatus, desiredChildren, err = sync(parentType, &req.Parent, &req.Children)
 		}
-		desiredStatus, desiredChildren, err = sync(parentType, &req.Parent, &req.Children)
+		observeSync(req.Parent.Kind, start, err)
 
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -91,8 +141,9 @@ func webhookHandler() func(w http.ResponseWriter, r *http.Request) {
 		}
 
 		resp := SyncResponse{
-			Status:   *desiredStatus,
-			Children: *desiredChildren,
+			Status:    *desiredStatus,
+			Children:  *desiredChildren,
+			Finalized: finalized,
 		}
 
 		data, err := json.Marshal(resp)
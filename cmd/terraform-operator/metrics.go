@@ -0,0 +1,181 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "terraform_operator"
+
+var (
+	syncDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "sync_duration_seconds",
+		Help:      "Time to reconcile a Terraform resource.",
+	}, []string{"kind"})
+
+	syncErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "sync_errors_total",
+		Help:      "Number of failed reconciles of Terraform resources.",
+	}, []string{"kind"})
+
+	runDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "run_duration_seconds",
+		Help:      "Duration of the finished Terraform runs.",
+		Buckets:   []float64{30, 60, 120, 300, 600, 1200, 1800, 3600, 7200},
+	}, []string{"kind", "status"})
+
+	runRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "run_retries_total",
+		Help:      "Number of retried Terraform runs.",
+	}, []string{"kind"})
+)
+
+func init() {
+	prometheus.MustRegister(syncDuration, syncErrors, runDuration, runRetries)
+}
+
+// observeSync records the latency and the error of a reconcile.
+func observeSync(kind string, start time.Time, err error) {
+	syncDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
+	if err != nil {
+		syncErrors.WithLabelValues(kind).Inc()
+	}
+}
+
+// recordRunMetrics records the runs that finished and the retries since the last status of the parent.
+func recordRunMetrics(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus) {
+	kind := string(parent.GetTFKind())
+
+	if status.RetryCount > parent.Status.RetryCount {
+		runRetries.WithLabelValues(kind).Add(float64(status.RetryCount - parent.Status.RetryCount))
+	}
+
+	finished := status.PodStatus == tfv1.PodStatusPassed || status.PodStatus == tfv1.PodStatusFailed
+	if !finished || status.FinishedAt == "" {
+		return
+	}
+	if status.FinishedAt == parent.Status.FinishedAt && status.PodStatus == parent.Status.PodStatus {
+		return
+	}
+	if duration, ok := getRunDuration(status); ok {
+		runDuration.WithLabelValues(kind, string(status.PodStatus)).Observe(duration.Seconds())
+	}
+}
+
+// getRunDuration returns the duration of the last run from the start and finish times in the status.
+func getRunDuration(status *tfv1.TerraformOperatorStatus) (time.Duration, bool) {
+	startedAt, err := time.Parse(time.RFC3339, status.StartedAt)
+	if err != nil {
+		return 0, false
+	}
+	finishedAt, err := time.Parse(time.RFC3339, status.FinishedAt)
+	if err != nil || finishedAt.Before(startedAt) {
+		return 0, false
+	}
+	return finishedAt.Sub(startedAt), true
+}
+
+var (
+	conditionStatusDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "condition_status"),
+		"Status of the conditions of the Terraform resources, 1 for the current status.",
+		[]string{"kind", "namespace", "name", "condition", "status"}, nil,
+	)
+	planResourcesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "plan_resources"),
+		"Number of resources in the last plan by action.",
+		[]string{"kind", "namespace", "name", "action"}, nil,
+	)
+	driftDetectedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "drift_detected"),
+		"1 if the last drift detection plan found changes.",
+		[]string{"kind", "namespace", "name"}, nil,
+	)
+	lastRunDurationDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "last_run_duration_seconds"),
+		"Duration of the last finished run.",
+		[]string{"kind", "namespace", "name", "status"}, nil,
+	)
+	retryCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "retry_count"),
+		"Number of retries of the current run.",
+		[]string{"kind", "namespace", "name"}, nil,
+	)
+)
+
+// terraformCollector reports the state of each Terraform resource from the informer cache,
+// the series of deleted resources are removed with them.
+type terraformCollector struct {
+	tfInformers *TerraformInformers
+}
+
+func newTerraformCollector(tfInformers *TerraformInformers) *terraformCollector {
+	return &terraformCollector{tfInformers: tfInformers}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *terraformCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- conditionStatusDesc
+	ch <- planResourcesDesc
+	ch <- driftDetectedDesc
+	ch <- lastRunDurationDesc
+	ch <- retryCountDesc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *terraformCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, kind := range []tfv1.TFKind{tfv1.TFKindPlan, tfv1.TFKindApply, tfv1.TFKindDestroy} {
+		tfs, err := c.tfInformers.List(kind)
+		if err != nil {
+			log.Printf("[WARN] Failed to list %s for metrics: %v", kind, err)
+			continue
+		}
+		for _, tf := range tfs {
+			collectTerraformMetrics(ch, string(kind), tf)
+		}
+	}
+}
+
+func collectTerraformMetrics(ch chan<- prometheus.Metric, kind string, tf tfv1.Terraform) {
+	ns, name := tf.GetNamespace(), tf.GetName()
+	status := tf.Status
+
+	for _, c := range status.Conditions {
+		for _, s := range []tfv1.ConditionStatus{tfv1.ConditionTrue, tfv1.ConditionFalse, tfv1.ConditionUnknown} {
+			ch <- prometheus.MustNewConstMetric(conditionStatusDesc, prometheus.GaugeValue, boolFloat(c.Status == s), kind, ns, name, string(c.Type), string(s))
+		}
+	}
+
+	if diff := status.TFPlanDiff; diff != nil {
+		ch <- prometheus.MustNewConstMetric(planResourcesDesc, prometheus.GaugeValue, float64(diff.Added), kind, ns, name, "add")
+		ch <- prometheus.MustNewConstMetric(planResourcesDesc, prometheus.GaugeValue, float64(diff.Changed), kind, ns, name, "change")
+		ch <- prometheus.MustNewConstMetric(planResourcesDesc, prometheus.GaugeValue, float64(diff.Destroyed), kind, ns, name, "destroy")
+		ch <- prometheus.MustNewConstMetric(planResourcesDesc, prometheus.GaugeValue, float64(diff.Replaced), kind, ns, name, "replace")
+	}
+
+	if status.Drift != nil && status.Drift.LastCheckedAt != "" {
+		ch <- prometheus.MustNewConstMetric(driftDetectedDesc, prometheus.GaugeValue, boolFloat(status.Drift.Drifted), kind, ns, name)
+	}
+
+	if status.PodStatus == tfv1.PodStatusPassed || status.PodStatus == tfv1.PodStatusFailed {
+		if duration, ok := getRunDuration(&status); ok {
+			ch <- prometheus.MustNewConstMetric(lastRunDurationDesc, prometheus.GaugeValue, duration.Seconds(), kind, ns, name, string(status.PodStatus))
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(retryCountDesc, prometheus.GaugeValue, float64(status.RetryCount), kind, ns, name)
+}
+
+func boolFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+func TestGetRunDuration(t *testing.T) {
+	tests := []struct {
+		name       string
+		startedAt  string
+		finishedAt string
+		expected   time.Duration
+		ok         bool
+	}{
+		{"finished", "2018-10-01T10:00:00Z", "2018-10-01T10:01:30Z", 90 * time.Second, true},
+		{"not started", "", "2018-10-01T10:01:30Z", 0, false},
+		{"finished before start", "2018-10-01T10:01:30Z", "2018-10-01T10:00:00Z", 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status := tfv1.TerraformOperatorStatus{StartedAt: tc.startedAt, FinishedAt: tc.finishedAt}
+			got, ok := getRunDuration(&status)
+			if got != tc.expected || ok != tc.ok {
+				t.Errorf("\n\texp: %#v, %v\n\n\tgot: %#v, %v", tc.expected, tc.ok, got, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	"github.com/ghodss/yaml"
+)
+
+// parsePlanPolicy parses a plan policy document in YAML format.
+func parsePlanPolicy(data string) (tfv1.TerraformPlanPolicy, error) {
+	var policy tfv1.TerraformPlanPolicy
+	if err := yaml.Unmarshal([]byte(data), &policy); err != nil {
+		return policy, err
+	}
+	for i, rule := range policy.Rules {
+		if rule.MaxCount < 0 {
+			return policy, fmt.Errorf("rules[%d]: maxCount must be >= 0", i)
+		}
+		for _, t := range rule.ResourceTypes {
+			if _, err := path.Match(t, ""); err != nil {
+				return policy, fmt.Errorf("rules[%d]: invalid resourceTypes pattern %q: %v", i, t, err)
+			}
+		}
+	}
+	return policy, nil
+}
+
+// evaluatePlanPolicy returns a violation for each rule whose matching resource changes exceed the rule maxCount.
+func evaluatePlanPolicy(policy tfv1.TerraformPlanPolicy, summary *tfv1.TerraformPlanFileSummary) []string {
+	violations := make([]string, 0)
+
+	for i, rule := range policy.Rules {
+		matched := make([]string, 0)
+		for _, r := range summary.Resources {
+			if ruleMatchesResource(rule, r) {
+				matched = append(matched, r.Address)
+			}
+		}
+
+		if len(matched) <= rule.MaxCount {
+			continue
+		}
+
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("rules[%d]", i)
+		}
+
+		sort.Strings(matched)
+		violation := fmt.Sprintf("%s: %d matching changes exceed max of %d: %s", name, len(matched), rule.MaxCount, strings.Join(matched, ", "))
+		if rule.Message != "" {
+			violation = fmt.Sprintf("%s (%s)", violation, rule.Message)
+		}
+		violations = append(violations, violation)
+	}
+
+	return violations
+}
+
+func ruleMatchesResource(rule tfv1.TerraformPlanPolicyRule, r tfv1.TerraformPlanResourceChange) bool {
+	if len(rule.Actions) > 0 {
+		found := false
+		for _, a := range rule.Actions {
+			if a == r.Action {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(rule.ResourceTypes) > 0 {
+		for _, t := range rule.ResourceTypes {
+			if ok, _ := path.Match(t, r.Type); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
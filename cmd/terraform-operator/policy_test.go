@@ -0,0 +1,82 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+func TestEvaluatePlanPolicy(t *testing.T) {
+	var summary tfv1.TerraformPlanFileSummary
+	if err := summarizeTFPlan(helperLoadBytes(t, "plan.json"), &summary); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		policy   string
+		expected []string
+	}{
+		{
+			"no rules",
+			``,
+			[]string{},
+		},
+		{
+			"deny database replace",
+			`
+rules:
+- name: no-db-replace
+  resourceTypes: ["google_sql_*"]
+  actions: [replace, delete]
+  message: database changes require a manual migration
+`,
+			[]string{"no-db-replace: 1 matching changes exceed max of 0: google_sql_database_instance.db (database changes require a manual migration)"},
+		},
+		{
+			"limit creates",
+			`
+rules:
+- actions: [create]
+  maxCount: 1
+`,
+			[]string{"rules[0]: 2 matching changes exceed max of 1: google_compute_network.default, module.network.google_compute_subnetwork.default"},
+		},
+		{
+			"within limits",
+			`
+rules:
+- actions: [delete]
+  maxCount: 1
+- resourceTypes: [google_container_cluster]
+`,
+			[]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := parsePlanPolicy(tc.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			violations := evaluatePlanPolicy(policy, &summary)
+			if !reflect.DeepEqual(tc.expected, violations) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, violations)
+			}
+		})
+	}
+}
+
+func TestParsePlanPolicyInvalid(t *testing.T) {
+	for _, data := range []string{
+		`rules: {}`,
+		`rules: [{maxCount: -1}]`,
+		`rules: [{resourceTypes: ["google_["]}]`,
+	} {
+		if _, err := parsePlanPolicy(data); err == nil {
+			t.Errorf("expected error for policy: %s", data)
+		}
+	}
+}
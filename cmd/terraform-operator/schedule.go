@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	"github.com/robfig/cron"
+)
+
+// startScheduledRun starts the next run when the spec schedule is due and records the last and next scheduled run.
+// Missed runs are skipped. Returns true if a new run was started.
+func startScheduledRun(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}, tfp *TFPod) (bool, error) {
+	if parent.Spec.Schedule == "" {
+		return false, nil
+	}
+
+	nextRun, err := getNextScheduledRun(parent, status)
+	if err != nil {
+		parent.Log("ERROR", "Failed to parse schedule: %v", err)
+		return false, err
+	}
+
+	tNow := time.Now()
+	if tNow.Before(nextRun) {
+		status.NextScheduledRun = nextRun.Format(time.RFC3339)
+		return false, nil
+	}
+
+	if err := startNextRun(parent, status, children, desiredChildren, tfp, "Scheduled run"); err != nil {
+		return false, err
+	}
+
+	status.LastScheduledRun = tNow.Format(time.RFC3339)
+	nextRun, _ = getNextScheduledRun(parent, status)
+	status.NextScheduledRun = nextRun.Format(time.RFC3339)
+
+	return true, nil
+}
+
+// startScheduledRunAfterFailure starts the next scheduled run after a failed run that is not retried, so a failure does not stop the schedule.
+// Returns the reasons for the condition.
+func startScheduledRunAfterFailure(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus, children *TerraformChildren, desiredChildren *[]interface{}, tfp *TFPod) []string {
+	started, err := startScheduledRun(parent, status, children, desiredChildren, tfp)
+	if err != nil {
+		return []string{fmt.Sprintf("Failed to start scheduled run: %v", err)}
+	}
+	if started {
+		return []string{fmt.Sprintf("Scheduled run: Pod/%s", status.PodName)}
+	}
+	return nil
+}
+
+// getNextScheduledRun returns the next time in the spec schedule after the last scheduled run, or after the parent was created.
+func getNextScheduledRun(parent *tfv1.Terraform, status *tfv1.TerraformOperatorStatus) (time.Time, error) {
+	schedule, err := cron.ParseStandard(parent.Spec.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	last := parent.GetCreationTimestamp().Time
+	if status.LastScheduledRun != "" {
+		if t, err := time.Parse(time.RFC3339, status.LastScheduledRun); err == nil {
+			last = t
+		}
+	}
+
+	return schedule.Next(last), nil
+}
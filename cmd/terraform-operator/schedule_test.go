@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetNextScheduledRun(t *testing.T) {
+	created := time.Date(2018, 9, 1, 10, 30, 0, 0, time.UTC)
+
+	parent := tfv1.Terraform{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: &tfv1.TerraformSpec{
+			Schedule: "0 */6 * * *",
+		},
+	}
+
+	tests := []struct {
+		name             string
+		lastScheduledRun string
+		expected         time.Time
+	}{
+		{"first run after creation", "", time.Date(2018, 9, 1, 12, 0, 0, 0, time.UTC)},
+		{"after last scheduled run", "2018-09-02T06:00:10Z", time.Date(2018, 9, 2, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status := tfv1.TerraformOperatorStatus{LastScheduledRun: tc.lastScheduledRun}
+			next, err := getNextScheduledRun(&parent, &status)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !next.Equal(tc.expected) {
+				t.Errorf("exp: %s, got: %s", tc.expected, next)
+			}
+		})
+	}
+}
+
+func TestStartScheduledRunAfterFailureNotDue(t *testing.T) {
+	parent := makeTestTerraform(tfv1.TFKindApply, "test")
+	parent.CreationTimestamp = metav1.NewTime(time.Now())
+	parent.Spec.Schedule = "0 0 1 1 *"
+
+	status := tfv1.TerraformOperatorStatus{PodName: "test-tfapply-0", PodStatus: tfv1.PodStatusFailed}
+	children := TerraformChildren{}
+	desiredChildren := make([]interface{}, 0)
+
+	if reasons := startScheduledRunAfterFailure(&parent, &status, &children, &desiredChildren, &TFPod{}); len(reasons) != 0 {
+		t.Errorf("expected no scheduled run, got: %v", reasons)
+	}
+	if status.NextScheduledRun == "" || status.PodStatus != tfv1.PodStatusFailed || len(desiredChildren) != 0 {
+		t.Errorf("expected the next scheduled run in the status, got: %#v", status)
+	}
+}
@@ -14,6 +14,7 @@ func sync(parentType ParentType, parent *tfv1.Terraform, children *TerraformChil
 	var err error
 	var status tfv1.TerraformOperatorStatus
 	copier.Copy(&status, &parent.Status)
+	status.ObservedGeneration = parent.GetGeneration()
 
 	desiredChildren := make([]interface{}, 0)
 
@@ -34,6 +35,9 @@ func sync(parentType ParentType, parent *tfv1.Terraform, children *TerraformChil
 		return &status, &desiredChildren, nil
 	}
 
+	// Drift detection pods are reconciled separately from the ordinal run pods.
+	children.splitDriftPods(parent)
+
 	// Variables shared by multiple conditions
 	var spec *tfv1.TerraformSpec
 	var providerConfigKeys ProviderConfigKeys
@@ -53,17 +57,22 @@ func sync(parentType ParentType, parent *tfv1.Terraform, children *TerraformChil
 		if spec != nil {
 			parent.Spec = spec
 
-			// Recompute conditions now that we have the spec.
-			conditions = parent.MakeConditions(tNow)
-			conditionOrder = parent.GetConditionOrder()
+			// The spec from the other resource is verified for the kind of this resource.
+			if err = parent.Verify(); err != nil {
+				parent.Log("ERROR", "Invalid spec from: %v", err)
+				newStatus = tfv1.ConditionFalse
+				condition.Reason = "Invalid spec"
+				condition.Message = fmt.Sprintf("%v", err)
+			} else {
+				// Recompute conditions now that we have the spec.
+				conditions = parent.MakeConditions(tNow)
+				conditionOrder = parent.GetConditionOrder()
 
-			conditions[tfv1.ConditionSpecFromReady] = condition
+				conditions[tfv1.ConditionSpecFromReady] = condition
+			}
 		}
 
-		if condition.Status != newStatus {
-			condition.LastTransitionTime = tNow
-			condition.Status = newStatus
-		}
+		setConditionStatus(parent, condition, newStatus, tNow)
 
 		// Short-circuit until specFrom condition is ready.
 		if condition.Status != tfv1.ConditionTrue {
@@ -84,10 +93,7 @@ func sync(parentType ParentType, parent *tfv1.Terraform, children *TerraformChil
 		if err = conditions.CheckConditions(conditionType); err != nil {
 			newStatus = tfv1.ConditionFalse
 			condition.Reason = err.Error()
-			if condition.Status != newStatus {
-				condition.LastTransitionTime = tNow
-				condition.Status = newStatus
-			}
+			setConditionStatus(parent, condition, newStatus, tNow)
 			continue
 		}
 
@@ -107,14 +113,28 @@ func sync(parentType ParentType, parent *tfv1.Terraform, children *TerraformChil
 		case tfv1.ConditionPlanReady:
 			newStatus, tfplanfile = reconcileTFPlanReady(condition, parent, &status, children, &desiredChildren)
 
+		case tfv1.ConditionPolicyPassed:
+			newStatus = reconcilePolicyPassed(condition, parent, &status, children, &desiredChildren)
+
+		case tfv1.ConditionApproved:
+			newStatus = reconcileApproved(condition, parent, &status, children, &desiredChildren)
+
 		case tfv1.ConditionPodComplete:
-			newStatus = reconcileTFPodReady(condition, parent, &status, children, &desiredChildren, &providerConfigKeys, &sourceData, &tfInputVars, &tfVarsFrom, tfplanfile)
+			if parent.Spec.Job != nil {
+				newStatus = reconcileTFJobComplete(condition, parent, &status, children, &desiredChildren, &providerConfigKeys, &sourceData, &tfInputVars, &tfVarsFrom, tfplanfile)
+			} else {
+				newStatus = reconcileTFPodReady(condition, parent, &status, children, &desiredChildren, &providerConfigKeys, &sourceData, &tfInputVars, &tfVarsFrom, tfplanfile)
+			}
+
+		case tfv1.ConditionDrifted:
+			newStatus = reconcileDrifted(condition, parent, &status, children, &desiredChildren, &providerConfigKeys, &sourceData, &tfInputVars, &tfVarsFrom)
 
 		case tfv1.ConditionReady:
 			newStatus = tfv1.ConditionTrue
 			notReady := []string{}
 			for _, c := range conditionOrder {
-				if c != tfv1.ConditionReady && conditions[c].Status != tfv1.ConditionTrue {
+				// Drifted is informational and does not affect readiness.
+				if c != tfv1.ConditionReady && c != tfv1.ConditionDrifted && conditions[c].Status != tfv1.ConditionTrue {
 					notReady = append(notReady, string(c))
 					newStatus = tfv1.ConditionFalse
 				}
@@ -126,10 +146,7 @@ func sync(parentType ParentType, parent *tfv1.Terraform, children *TerraformChil
 			}
 		}
 
-		if condition.Status != newStatus {
-			condition.LastTransitionTime = tNow
-			condition.Status = newStatus
-		}
+		setConditionStatus(parent, condition, newStatus, tNow)
 	}
 
 	// Set the ordered condition status from the conditions map.
@@ -139,5 +156,17 @@ func sync(parentType ParentType, parent *tfv1.Terraform, children *TerraformChil
 	}
 	status.Conditions = newConditions
 
+	recordRunMetrics(parent, &status)
+
 	return &status, &desiredChildren, nil
 }
+
+// setConditionStatus updates the condition status and records an Event when it transitions.
+func setConditionStatus(parent *tfv1.Terraform, condition *tfv1.Condition, newStatus tfv1.ConditionStatus, tNow metav1.Time) {
+	if condition.Status == newStatus {
+		return
+	}
+	recordConditionEvent(parent, condition, newStatus)
+	condition.LastTransitionTime = tNow
+	condition.Status = newStatus
+}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
 	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -45,14 +47,16 @@ const (
 
 // SyncRequest describes the payload from the CompositeController hook
 type SyncRequest struct {
-	Parent   tfv1.Terraform    `json:"parent"`
-	Children TerraformChildren `json:"children"`
+	Parent     tfv1.Terraform    `json:"parent"`
+	Children   TerraformChildren `json:"children"`
+	Finalizing bool              `json:"finalizing"`
 }
 
 // SyncResponse is the CompositeController response structure.
 type SyncResponse struct {
-	Status   tfv1.TerraformOperatorStatus `json:"status"`
-	Children []interface{}                `json:"children"`
+	Status    tfv1.TerraformOperatorStatus `json:"status"`
+	Children  []interface{}                `json:"children"`
+	Finalized bool                         `json:"finalized,omitempty"`
 }
 
 // TerraformChildren is the children definition passed by the CompositeController request for the Terraform controller.
@@ -60,6 +64,22 @@ type TerraformChildren struct {
 	Pods       map[string]corev1.Pod       `json:"Pod.v1"`
 	ConfigMaps map[string]corev1.ConfigMap `json:"ConfigMap.v1"`
 	Secrets    map[string]corev1.Secret    `json:"Secret.v1"`
+	Jobs       map[string]batchv1.Job      `json:"Job.batch/v1"`
+
+	// DriftPods are the drift detection pods, split from the Pods by splitDriftPods.
+	DriftPods map[string]corev1.Pod `json:"-"`
+}
+
+// splitDriftPods moves the drift detection pods out of the Pods map so that they are not considered as ordinal run pods.
+func (children *TerraformChildren) splitDriftPods(parent *tfv1.Terraform) {
+	children.DriftPods = make(map[string]corev1.Pod, 0)
+	driftPodName := regexp.MustCompile(fmt.Sprintf(`^%s-%s-drift-[0-9]+$`, regexp.QuoteMeta(parent.GetName()), parent.GetTFKindShort()))
+	for name, pod := range children.Pods {
+		if driftPodName.MatchString(name) {
+			children.DriftPods[name] = pod
+			delete(children.Pods, name)
+		}
+	}
 }
 
 func (children *TerraformChildren) claimChildAndGetCurrent(newChild interface{}, desiredChildren *[]interface{}) interface{} {
@@ -68,6 +88,8 @@ func (children *TerraformChildren) claimChildAndGetCurrent(newChild interface{},
 	case corev1.Pod:
 		if child, ok := children.Pods[o.GetName()]; ok == true {
 			currChild = child
+		} else if child, ok := children.DriftPods[o.GetName()]; ok == true {
+			currChild = child
 		}
 	case corev1.ConfigMap:
 		if child, ok := children.ConfigMaps[o.GetName()]; ok == true {
@@ -77,6 +99,10 @@ func (children *TerraformChildren) claimChildAndGetCurrent(newChild interface{},
 		if child, ok := children.Secrets[o.GetName()]; ok == true {
 			currChild = child
 		}
+	case batchv1.Job:
+		if child, ok := children.Jobs[o.GetName()]; ok == true {
+			currChild = child
+		}
 	}
 
 	*desiredChildren = append(*desiredChildren, newChild)
@@ -2,15 +2,20 @@ package main
 
 import (
 	"bytes"
+	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os/exec"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/buger/jsonparser"
 	tfv1 "github.com/danisla/terraform-operator/pkg/types"
-	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,6 +31,90 @@ func parseTerraformPlan(planfile string) (tfv1.TerraformPlanFileSummary, error)
 }
 
 func summarizeTFPlan(data []byte, summary *tfv1.TerraformPlanFileSummary) error {
+	// Plans from Terraform 0.12+ use the `terraform show -json` format, identified by the format_version key.
+	if _, err := jsonparser.GetString(data, "format_version"); err == nil {
+		return summarizeTFPlanJSON(data, summary)
+	}
+	return summarizeTFPlanModule(data, []string{}, summary)
+}
+
+// TerraformPlanJSON is the subset of the `terraform show -json` plan representation used to summarize the plan.
+type TerraformPlanJSON struct {
+	FormatVersion   string                      `json:"format_version"`
+	ResourceChanges []TerraformPlanJSONResource `json:"resource_changes"`
+}
+
+// TerraformPlanJSONResource is an element of the resource_changes list in the JSON plan.
+type TerraformPlanJSONResource struct {
+	Address       string `json:"address"`
+	ModuleAddress string `json:"module_address"`
+	Mode          string `json:"mode"`
+	Type          string `json:"type"`
+	Change        struct {
+		Actions      []string                   `json:"actions"`
+		Before       map[string]json.RawMessage `json:"before"`
+		After        map[string]json.RawMessage `json:"after"`
+		AfterUnknown map[string]json.RawMessage `json:"after_unknown"`
+	} `json:"change"`
+}
+
+func summarizeTFPlanJSON(data []byte, summary *tfv1.TerraformPlanFileSummary) error {
+	var plan TerraformPlanJSON
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return fmt.Errorf("Failed to parse JSON plan: %v", err)
+	}
+
+	for _, rc := range plan.ResourceChanges {
+		r := tfv1.TerraformPlanResourceChange{
+			Address: rc.Address,
+			Module:  rc.ModuleAddress,
+			Type:    rc.Type,
+		}
+
+		actions := strings.Join(rc.Change.Actions, ",")
+		switch actions {
+		case "create":
+			r.Action = tfv1.PlanActionCreate
+		case "update":
+			r.Action = tfv1.PlanActionUpdate
+		case "delete":
+			r.Action = tfv1.PlanActionDelete
+		case "delete,create", "create,delete":
+			r.Action = tfv1.PlanActionReplace
+		default:
+			// no-op and read actions do not change any resources.
+			continue
+		}
+
+		if r.Action != tfv1.PlanActionDelete {
+			attrs := make(map[string]bool, 0)
+			for k, after := range rc.Change.After {
+				before, ok := rc.Change.Before[k]
+				if ok == false || bytes.Equal(before, after) == false {
+					if ok == false && string(after) == "null" {
+						continue
+					}
+					attrs[k] = true
+				}
+			}
+			for k, unknown := range rc.Change.AfterUnknown {
+				if string(unknown) != "false" {
+					attrs[k] = true
+				}
+			}
+			for k := range attrs {
+				r.Attributes = append(r.Attributes, k)
+			}
+			sort.Strings(r.Attributes)
+		}
+
+		summary.AddResource(r)
+	}
+
+	return nil
+}
+
+func summarizeTFPlanModule(data []byte, modulePath []string, summary *tfv1.TerraformPlanFileSummary) error {
 	modData, vt, _, err := jsonparser.Get(data, []string{}...)
 	if err != nil {
 		return err
@@ -35,34 +124,22 @@ func summarizeTFPlan(data []byte, summary *tfv1.TerraformPlanFileSummary) error
 	}
 
 	return jsonparser.ObjectEach(modData, func(key []byte, value []byte, vt jsonparser.ValueType, offset int) error {
-		toks := strings.Split(string(key), ".")
-
-		if vt == jsonparser.Object {
-			if len(toks) == 2 {
-				// Resource
-				id, err := jsonparser.GetString(value, "id")
-				if err != nil {
-					return fmt.Errorf("Failed to extract 'id' key as string: %v", err)
-				}
-
-				d, err := jsonparser.GetBoolean(value, "destroy")
-				if err != nil {
-					return fmt.Errorf("Failed to extract 'destroy' key as boolean: %v", err)
-				}
+		if vt != jsonparser.Object {
+			return nil
+		}
 
-				if d == true {
-					summary.Destroyed++
-				} else if id == "" {
-					summary.Added++
-				} else {
-					summary.Changed++
-				}
-			} else if string(key) != "destroy" && string(key) != "destroy_tainted" {
-				// Nested module
-				err := summarizeTFPlan(value, summary)
-				if err != nil {
-					return err
-				}
+		if strings.Contains(string(key), ".") {
+			// Resource keys are of the form TYPE.NAME, TYPE.NAME.INDEX or data.TYPE.NAME
+			r, err := makePlanResourceChange(string(key), modulePath, value)
+			if err != nil {
+				return err
+			}
+			summary.AddResource(r)
+		} else {
+			// Nested module
+			err := summarizeTFPlanModule(value, append(modulePath, string(key)), summary)
+			if err != nil {
+				return err
 			}
 		}
 
@@ -70,40 +147,107 @@ func summarizeTFPlan(data []byte, summary *tfv1.TerraformPlanFileSummary) error
 	}, []string{}...)
 }
 
-func runTFJson(planfile string) (string, error) {
+// Keys in the tfjson-service resource output that are not resource attributes.
+var tfjsonReservedKeys = map[string]bool{
+	"destroy":         true,
+	"destroy_tainted": true,
+	"change_type":     true,
+}
 
-	var stdout bytes.Buffer
-	var stderr bytes.Buffer
+func makePlanResourceChange(key string, modulePath []string, value []byte) (tfv1.TerraformPlanResourceChange, error) {
+	r := tfv1.TerraformPlanResourceChange{}
 
-	cmd := exec.Command("tfjson-service", planfile)
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	// Module address, the root module is the first element of the path.
+	moduleToks := make([]string, 0)
+	for i, m := range modulePath {
+		if i == 0 && m == "root" {
+			continue
+		}
+		moduleToks = append(moduleToks, fmt.Sprintf("module.%s", m))
+	}
+	r.Module = strings.Join(moduleToks, ".")
 
-	err := cmd.Run()
+	toks := strings.Split(key, ".")
+	if _, err := strconv.Atoi(toks[len(toks)-1]); err == nil && len(toks) > 2 {
+		// Convert count index to address format.
+		index := toks[len(toks)-1]
+		toks = toks[:len(toks)-1]
+		toks[len(toks)-1] = fmt.Sprintf("%s[%s]", toks[len(toks)-1], index)
+	}
+	r.Type = toks[0]
+	if toks[0] == "data" && len(toks) > 2 {
+		r.Type = toks[1]
+	}
+	r.Address = strings.Join(append(moduleToks, strings.Join(toks, ".")), ".")
+
+	// Top level names of the changed attributes.
+	attrs := make(map[string]bool, 0)
+	id, hasID := "", false
+	err := jsonparser.ObjectEach(value, func(k []byte, v []byte, vt jsonparser.ValueType, offset int) error {
+		name := string(k)
+		if tfjsonReservedKeys[name] {
+			return nil
+		}
+		if name == "id" {
+			hasID = true
+			id = string(v)
+		}
+		attrs[strings.Split(name, ".")[0]] = true
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("Failed to run gsutil: %s\n%v", stderr.String(), err)
+		return r, err
 	}
+	for k := range attrs {
+		r.Attributes = append(r.Attributes, k)
+	}
+	sort.Strings(r.Attributes)
 
-	return stdout.String(), err
+	changeType, err := jsonparser.GetString(value, "change_type")
+	if err == nil {
+		r.Action = tfv1.PlanAction(changeType)
+		return r, nil
+	}
+
+	// Output from older versions of tfjson-service does not include the change type, derive it from the diff.
+	d, err := jsonparser.GetBoolean(value, "destroy")
+	if err != nil {
+		return r, fmt.Errorf("Failed to extract 'destroy' key as boolean: %v", err)
+	}
+
+	if d == true && len(r.Attributes) > 0 {
+		r.Action = tfv1.PlanActionReplace
+	} else if d == true {
+		r.Action = tfv1.PlanActionDelete
+	} else if hasID && id == "" {
+		// New resources have a computed id.
+		r.Action = tfv1.PlanActionCreate
+	} else {
+		r.Action = tfv1.PlanActionUpdate
+	}
+
+	return r, nil
 }
 
-func getTerraform(kind tfv1.TFKind, namespace string, name string) (tfv1.Terraform, error) {
-	var tfapply tfv1.Terraform
+func runTFJson(planfile string) (string, error) {
+
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
 
-	cmd := exec.Command("kubectl", "get", string(kind), "-n", namespace, name, "-o", "yaml")
+	cmd := exec.Command("tfjson-service", planfile)
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
 	err := cmd.Run()
 	if err != nil {
-		return tfapply, fmt.Errorf("Failed to run kubectl: %s\n%v", stderr.String(), err)
+		return "", fmt.Errorf("Failed to run gsutil: %s\n%v", stderr.String(), err)
 	}
 
-	err = yaml.Unmarshal(stdout.Bytes(), &tfapply)
+	return stdout.String(), err
+}
 
-	return tfapply, err
+func getTerraform(kind tfv1.TFKind, namespace string, name string) (tfv1.Terraform, error) {
+	return tfInformers.Get(kind, namespace, name)
 }
 
 func getSecretKeys(namespace string, name string) ([]string, error) {
@@ -122,12 +266,40 @@ func getConfigMapSourceData(namespace string, name string) (ConfigMapSourceData,
 	return configMap.Data, err
 }
 
+// getJobPods returns the pods created by the Job.
+func getJobPods(namespace string, jobName string) ([]corev1.Pod, error) {
+	pods, err := config.clientset.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s", jobName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
 func toSha1(data string) string {
 	h := sha1.New()
 	h.Write([]byte(data))
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// toSaltedSha256 returns the SHA-256 of the salt and data, used for values that must not be guessable from their hash.
+func toSaltedSha256(salt string, data string) string {
+	h := sha256.New()
+	h.Write([]byte(salt))
+	h.Write([]byte(data))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// makeSalt returns a random hex encoded salt.
+func makeSalt() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 func getVarsFromTF(kind tfv1.TFKind, namespace, name string) (TerraformInputVars, error) {
 	tfVars := make(TerraformInputVars, 0)
 	tf, err := getTerraform(kind, namespace, name)
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+)
+
+func helperLoadBytes(t *testing.T, name string) []byte {
+	path := filepath.Join("testdata", name) // relative path
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes
+}
+
+func TestSummarizeTFPlan(t *testing.T) {
+	expected := tfv1.TerraformPlanFileSummary{
+		Added:     2,
+		Changed:   1,
+		Destroyed: 1,
+		Replaced:  1,
+		Resources: []tfv1.TerraformPlanResourceChange{
+			{
+				Address:    "google_compute_instance.web[0]",
+				Type:       "google_compute_instance",
+				Action:     tfv1.PlanActionUpdate,
+				Attributes: []string{"machine_type"},
+			},
+			{
+				Address:    "google_compute_network.default",
+				Type:       "google_compute_network",
+				Action:     tfv1.PlanActionCreate,
+				Attributes: []string{"auto_create_subnetworks", "id", "name", "self_link"},
+			},
+			{
+				Address:    "google_sql_database_instance.db",
+				Type:       "google_sql_database_instance",
+				Action:     tfv1.PlanActionReplace,
+				Attributes: []string{"id", "region", "settings"},
+			},
+			{
+				Address: "google_storage_bucket.old",
+				Type:    "google_storage_bucket",
+				Action:  tfv1.PlanActionDelete,
+			},
+			{
+				Address:    "module.network.google_compute_subnetwork.default",
+				Module:     "module.network",
+				Type:       "google_compute_subnetwork",
+				Action:     tfv1.PlanActionCreate,
+				Attributes: []string{"id", "ip_cidr_range"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		fixture string
+	}{
+		{"tfjson-service legacy output", "tfjson-legacy.json"},
+		{"tfjson-service output", "tfjson.json"},
+		{"terraform show -json output", "plan.json"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var summary tfv1.TerraformPlanFileSummary
+			if err := summarizeTFPlan(helperLoadBytes(t, tc.fixture), &summary); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(expected, summary) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", expected, summary)
+			}
+		})
+	}
+}
+
+func TestSummarizeTFPlanInvalid(t *testing.T) {
+	var summary tfv1.TerraformPlanFileSummary
+	if err := summarizeTFPlan([]byte(`["not", "a", "plan"]`), &summary); err == nil {
+		t.Errorf("expected error for invalid plan")
+	}
+}
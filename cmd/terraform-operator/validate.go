@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// terraformLookup returns a Terraform object from the same namespace, the error is NotFound if it does not exist.
+type terraformLookup func(kind tfv1.TFKind, namespace, name string) (tfv1.Terraform, error)
+
+// terraformRef is a reference to another Terraform object in the same namespace.
+type terraformRef struct {
+	Kind tfv1.TFKind
+	Name string
+}
+
+func (r terraformRef) String() string {
+	return fmt.Sprintf("%s/%s", r.Kind, r.Name)
+}
+
+// validateTerraform runs the spec verification and the checks that need the other Terraform objects.
+func validateTerraform(parent *tfv1.Terraform, lookup terraformLookup) error {
+	switch parent.GetTFKind() {
+	case tfv1.TFKindPlan, tfv1.TFKindApply, tfv1.TFKindDestroy:
+	default:
+		return fmt.Errorf("Unsupported kind: %s", parent.Kind)
+	}
+
+	if parent.Spec != nil && parent.SpecFrom != nil {
+		return fmt.Errorf("'spec' and 'specFrom' are mutually exclusive")
+	}
+
+	if err := parent.Verify(); err != nil {
+		return err
+	}
+
+	if parent.SpecFrom != nil {
+		if err := validateSpecFrom(parent.SpecFrom); err != nil {
+			return err
+		}
+	}
+
+	if parent.Spec != nil {
+		if err := validateSpecRefs(parent.Spec); err != nil {
+			return err
+		}
+	}
+
+	return validateNoCycles(parent, lookup)
+}
+
+func validateSpecFrom(specFrom *tfv1.TerraformSpecFrom) error {
+	count := 0
+	for _, name := range []string{specFrom.TFPlan, specFrom.TFApply, specFrom.TFDestroy} {
+		if name != "" {
+			count++
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("'specFrom' must have exactly one of: tfplan, tfapply, tfdestroy")
+	}
+	return nil
+}
+
+func validateSpecRefs(spec *tfv1.TerraformSpec) error {
+	for i, source := range spec.Sources {
+		count := 0
+		if source.ConfigMap != nil {
+			count++
+			if source.ConfigMap.Name == "" {
+				return fmt.Errorf("Missing 'spec.sources[%d].configMap.name'", i)
+			}
+		}
+		for _, v := range []string{source.Embedded, source.GCS, source.TFPlan, source.TFApply} {
+			if v != "" {
+				count++
+			}
+		}
+		if count != 1 {
+			return fmt.Errorf("'spec.sources[%d]' must have exactly one of: configMap, embedded, gcs, tfplan, tfapply", i)
+		}
+	}
+
+	// Destination vars must be unique across the tfvars and the tfinputs.
+	dests := make(map[string]string, 0)
+	if spec.TFVars != nil {
+		for i, v := range *spec.TFVars {
+			dests[v.Name] = fmt.Sprintf("spec.tfvars[%d]", i)
+		}
+	}
+	if spec.TFInputs != nil {
+		for i, tfinput := range *spec.TFInputs {
+			if tfinput.Name == "" {
+				return fmt.Errorf("Missing 'spec.tfinputs[%d].name'", i)
+			}
+			for j, item := range tfinput.VarMap {
+				field := fmt.Sprintf("spec.tfinputs[%d].varMap[%d]", i, j)
+				if item.Source == "" || item.Dest == "" {
+					return fmt.Errorf("'%s' must have a source and a dest", field)
+				}
+				if other, ok := dests[item.Dest]; ok {
+					return fmt.Errorf("'%s' dest %s is already set by '%s'", field, item.Dest, other)
+				}
+				dests[item.Dest] = field
+			}
+		}
+	}
+
+	if spec.TFVarsFrom != nil {
+		for i, varsFrom := range *spec.TFVarsFrom {
+			if (varsFrom.TFApply == "") == (varsFrom.TFPlan == "") {
+				return fmt.Errorf("'spec.tfvarsFrom[%d]' must have exactly one of: tfapply, tfplan", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getTerraformRefs returns the Terraform objects that the parent reads its spec, config, or vars from.
+func getTerraformRefs(parent *tfv1.Terraform) []terraformRef {
+	refs := make([]terraformRef, 0)
+
+	if parent.SpecFrom != nil {
+		switch {
+		case parent.SpecFrom.TFPlan != "":
+			refs = append(refs, terraformRef{tfv1.TFKindPlan, parent.SpecFrom.TFPlan})
+		case parent.SpecFrom.TFApply != "":
+			refs = append(refs, terraformRef{tfv1.TFKindApply, parent.SpecFrom.TFApply})
+		case parent.SpecFrom.TFDestroy != "":
+			refs = append(refs, terraformRef{tfv1.TFKindDestroy, parent.SpecFrom.TFDestroy})
+		}
+	}
+
+	if parent.Spec == nil {
+		return refs
+	}
+
+	if parent.Spec.TFPlan != "" {
+		refs = append(refs, terraformRef{tfv1.TFKindPlan, parent.Spec.TFPlan})
+	}
+
+	for _, source := range parent.Spec.Sources {
+		if source.TFPlan != "" {
+			refs = append(refs, terraformRef{tfv1.TFKindPlan, source.TFPlan})
+		}
+		if source.TFApply != "" {
+			refs = append(refs, terraformRef{tfv1.TFKindApply, source.TFApply})
+		}
+	}
+
+	if parent.Spec.TFInputs != nil {
+		for _, tfinput := range *parent.Spec.TFInputs {
+			refs = append(refs, terraformRef{tfv1.TFKindApply, tfinput.Name})
+		}
+	}
+
+	if parent.Spec.TFVarsFrom != nil {
+		for _, varsFrom := range *parent.Spec.TFVarsFrom {
+			if varsFrom.TFPlan != "" {
+				refs = append(refs, terraformRef{tfv1.TFKindPlan, varsFrom.TFPlan})
+			}
+			if varsFrom.TFApply != "" {
+				refs = append(refs, terraformRef{tfv1.TFKindApply, varsFrom.TFApply})
+			}
+		}
+	}
+
+	return refs
+}
+
+// validateNoCycles follows the references of the parent through the other Terraform objects and fails if they lead back to it.
+// Objects that do not exist yet are skipped.
+func validateNoCycles(parent *tfv1.Terraform, lookup terraformLookup) error {
+	self := terraformRef{parent.GetTFKind(), parent.GetName()}
+	visited := make(map[terraformRef]bool, 0)
+
+	var visit func(ref terraformRef, path []string) error
+	visit = func(ref terraformRef, path []string) error {
+		// Copy the path so that sibling references do not share its backing array.
+		path = append(append(make([]string, 0, len(path)+1), path...), ref.String())
+		if ref == self && len(path) > 1 {
+			return fmt.Errorf("Cycle detected: %s", strings.Join(path, " -> "))
+		}
+		if visited[ref] {
+			return nil
+		}
+		visited[ref] = true
+
+		obj := parent
+		if ref != self {
+			tf, err := lookup(ref.Kind, parent.GetNamespace(), ref.Name)
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			tf.Kind = string(ref.Kind)
+			obj = &tf
+		}
+
+		for _, next := range getTerraformRefs(obj) {
+			if err := visit(next, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return visit(self, nil)
+}
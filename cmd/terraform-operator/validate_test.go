@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func makeTestTerraform(kind tfv1.TFKind, name string, sources ...tfv1.TerraformConfigSource) tfv1.Terraform {
+	if len(sources) == 0 {
+		sources = []tfv1.TerraformConfigSource{{Embedded: "resource \"null_resource\" \"test\" {}"}}
+	}
+	return tfv1.Terraform{
+		TypeMeta:   metav1.TypeMeta{Kind: string(kind)},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: &tfv1.TerraformSpec{
+			ProviderConfig: &[]tfv1.TerraformSpecProviderConfig{{Name: "google", SecretName: "tf-provider-google"}},
+			Sources:        sources,
+		},
+	}
+}
+
+func makeTestLookup(objs ...tfv1.Terraform) terraformLookup {
+	return func(kind tfv1.TFKind, namespace, name string) (tfv1.Terraform, error) {
+		for _, obj := range objs {
+			if obj.GetTFKind() == kind && obj.GetNamespace() == namespace && obj.GetName() == name {
+				return obj, nil
+			}
+		}
+		return tfv1.Terraform{}, apierrors.NewNotFound(schema.GroupResource{Group: tfv1.Group, Resource: string(kind)}, name)
+	}
+}
+
+func TestValidateTerraform(t *testing.T) {
+	tfapplyA := makeTestTerraform(tfv1.TFKindApply, "a", tfv1.TerraformConfigSource{TFApply: "b"})
+	tfapplyB := makeTestTerraform(tfv1.TFKindApply, "b")
+	tfapplyB.Spec.TFInputs = &[]tfv1.TerraformConfigInputs{{Name: "a", VarMap: []tfv1.VarMapItem{{Source: "x", Dest: "y"}}}}
+
+	tfplanD := makeTestTerraform(tfv1.TFKindPlan, "d")
+	tfplanD.Spec = nil
+	tfplanD.SpecFrom = &tfv1.TerraformSpecFrom{TFApply: "e"}
+	tfapplyE := makeTestTerraform(tfv1.TFKindApply, "e")
+	tfapplyE.Spec.TFPlan = "d"
+
+	lookup := makeTestLookup(tfapplyA, tfapplyB, tfplanD, tfapplyE)
+
+	specAndSpecFrom := makeTestTerraform(tfv1.TFKindApply, "c")
+	specAndSpecFrom.SpecFrom = &tfv1.TerraformSpecFrom{TFPlan: "a"}
+
+	multipleSources := makeTestTerraform(tfv1.TFKindApply, "c", tfv1.TerraformConfigSource{Embedded: "data", GCS: "gs://bucket/config.tgz"})
+
+	duplicateDest := makeTestTerraform(tfv1.TFKindApply, "c")
+	duplicateDest.Spec.TFVars = &[]tfv1.TFVar{{Name: "region", Value: "us-central1"}}
+	duplicateDest.Spec.TFInputs = &[]tfv1.TerraformConfigInputs{{Name: "b", VarMap: []tfv1.VarMapItem{{Source: "region", Dest: "region"}}}}
+
+	multipleSpecFrom := tfv1.Terraform{
+		TypeMeta:   metav1.TypeMeta{Kind: string(tfv1.TFKindDestroy)},
+		ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "default"},
+		SpecFrom:   &tfv1.TerraformSpecFrom{TFPlan: "a", TFApply: "a"},
+	}
+
+	validSpecFrom := multipleSpecFrom
+	validSpecFrom.SpecFrom = &tfv1.TerraformSpecFrom{TFApply: "b"}
+
+	s3Backend := &tfv1.TerraformBackend{Type: tfv1.BackendS3, Config: map[string]string{"bucket": "tf-state", "key": "example"}}
+
+	s3Apply := makeTestTerraform(tfv1.TFKindApply, "c")
+	s3Apply.Spec.Backend = s3Backend
+
+	s3ApplyTFPlan := makeTestTerraform(tfv1.TFKindApply, "c")
+	s3ApplyTFPlan.Spec.Backend = s3Backend
+	s3ApplyTFPlan.Spec.TFPlan = "a"
+
+	s3Plan := makeTestTerraform(tfv1.TFKindPlan, "c")
+	s3Plan.Spec.Backend = s3Backend
+
+	scheduledTFPlan := makeTestTerraform(tfv1.TFKindApply, "c")
+	scheduledTFPlan.Spec.Schedule = "0 */6 * * *"
+	scheduledTFPlan.Spec.TFPlan = "a"
+
+	tests := []struct {
+		name     string
+		parent   tfv1.Terraform
+		expected error
+	}{
+		{"valid", makeTestTerraform(tfv1.TFKindApply, "c", tfv1.TerraformConfigSource{TFApply: "b"}), nil},
+		{"valid specFrom", validSpecFrom, nil},
+		{"cycle", tfapplyA, fmt.Errorf("Cycle detected: TerraformApply/a -> TerraformApply/b -> TerraformApply/a")},
+		{"tfplan cycle", tfplanD, fmt.Errorf("Cycle detected: TerraformPlan/d -> TerraformApply/e -> TerraformPlan/d")},
+		{"spec and specFrom", specAndSpecFrom, fmt.Errorf("'spec' and 'specFrom' are mutually exclusive")},
+		{"multiple sources", multipleSources, fmt.Errorf("'spec.sources[0]' must have exactly one of: configMap, embedded, gcs, tfplan, tfapply")},
+		{"duplicate dest", duplicateDest, fmt.Errorf("'spec.tfinputs[0].varMap[0]' dest region is already set by 'spec.tfvars[0]'")},
+		{"multiple specFrom", multipleSpecFrom, fmt.Errorf("'specFrom' must have exactly one of: tfplan, tfapply, tfdestroy")},
+		{"schedule with tfplan", scheduledTFPlan, fmt.Errorf("'spec.schedule' cannot be used with 'spec.tfplan'")},
+		{"s3 backend", s3Apply, nil},
+		{"s3 backend tfplan", s3ApplyTFPlan, nil},
+		{"s3 backend plan", s3Plan, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := validateTerraform(&tc.parent, lookup)
+			if fmt.Sprintf("%v", got) != fmt.Sprintf("%v", tc.expected) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+#!/usr/bin/env bash
+
+# Generates the deepcopy, conversion, clientset, lister and informer code for the API in pkg/apis.
+# Requires k8s.io/code-generator kubernetes-1.16, for the client-go API without contexts, checked out in the GOPATH or set CODEGEN_PKG.
+# informer-gen must name the group package for the dotted group directory "ctl".
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(dirname ${BASH_SOURCE})/..
+CODEGEN_PKG=${CODEGEN_PKG:-$(cd ${SCRIPT_ROOT}; ls -d -1 ./vendor/k8s.io/code-generator 2>/dev/null || echo ${GOPATH}/src/k8s.io/code-generator)}
+
+PKG=github.com/danisla/terraform-operator
+APIS_PKG=${PKG}/pkg/apis/ctl.isla.solutions/v1alpha2
+
+# Generated files have no license header.
+HEADER=$(mktemp)
+trap "rm -f ${HEADER}" EXIT
+
+${CODEGEN_PKG}/generate-groups.sh "deepcopy,client,informer,lister" \
+  ${PKG}/pkg/client ${PKG}/pkg/apis \
+  ctl.isla.solutions:v1alpha2 \
+  --go-header-file ${HEADER}
+
+go install ${CODEGEN_PKG}/cmd/conversion-gen
+${GOPATH}/bin/conversion-gen \
+  --input-dirs ${APIS_PKG} \
+  -O zz_generated.conversion \
+  --go-header-file ${HEADER}
@@ -4,6 +4,8 @@ set -x
 set -e
 set -o pipefail
 
+source "$(dirname "$0")/termination-log.sh"
+
 mkdir -p ${PWD}/.terraform
 
 if [[ -z ${GCS_TARBALLS+x} ]]; then
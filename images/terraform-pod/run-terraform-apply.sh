@@ -3,6 +3,8 @@
 set -e
 set -o pipefail
 
+source "$(dirname "$0")/termination-log.sh"
+
 mkdir -p ${PWD}/.terraform
 
 function downloadPlan() {
@@ -18,6 +20,15 @@ EOF
   gcloud config set project $PROJECT_ID
 
   gsutil cp ${tfplan} ${dest}
+
+  # The plan must match the hash of the approved plan.
+  if [[ -n "${TFPLAN_HASH}" ]]; then
+    local planHash=$(sha256sum "${dest}" | cut -d' ' -f1)
+    if [[ "${planHash}" != "${TFPLAN_HASH}" ]]; then
+      echo "Error: Plan ${tfplan} hash ${planHash} does not match the approved plan hash ${TFPLAN_HASH}"
+      exit 1
+    fi
+  fi
 }
 
 # Decode any *.b64 files
@@ -25,19 +36,15 @@ find . -maxdepth 1 -mindepth 1 -name "*.b64" -exec sh -c "base64 -d {} > \$(base
 
 terraform version
 
-cat > backend.tf <<EOF
-terraform {
-  backend "gcs" {
-    bucket = "${BACKEND_BUCKET}"
-    prefix = "${BACKEND_PREFIX}"
-  }
-}
-EOF
+# Backend block rendered by the operator from the spec.
+echo "${BACKEND_CONFIG}" > backend.tf
 
 tree
 
 terraform init -upgrade=true
-terraform workspace select ${WORKSPACE} || terraform workspace new ${WORKSPACE}
+if [[ -n "${WORKSPACE}" ]]; then
+  terraform workspace select ${WORKSPACE} || terraform workspace new ${WORKSPACE}
+fi
 
 if [[ -n ${TFPLAN+x} ]]; then
     downloadPlan ${TFPLAN} terraform.tfplan
@@ -3,6 +3,8 @@
 set -e
 set -o pipefail
 
+source "$(dirname "$0")/termination-log.sh"
+
 mkdir -p ${PWD}/.terraform
 
 # Decode any *.b64 files
@@ -10,17 +12,13 @@ find . -maxdepth 1 -mindepth 1 -name "*.b64" -exec sh -c "base64 -d {} > \$(base
 
 terraform version
 
-cat > backend.tf <<EOF
-terraform {
-  backend "gcs" {
-    bucket = "${BACKEND_BUCKET}"
-    prefix = "${BACKEND_PREFIX}"
-  }
-}
-EOF
+# Backend block rendered by the operator from the spec.
+echo "${BACKEND_CONFIG}" > backend.tf
 
 tree
 
 terraform init -upgrade=true
-terraform workspace select ${WORKSPACE} || terraform workspace new ${WORKSPACE}
+if [[ -n "${WORKSPACE}" ]]; then
+  terraform workspace select ${WORKSPACE} || terraform workspace new ${WORKSPACE}
+fi
 terraform destroy -input=false -lock=false -auto-approve
\ No newline at end of file
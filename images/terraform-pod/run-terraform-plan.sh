@@ -3,6 +3,8 @@
 set -e
 set -o pipefail
 
+source "$(dirname "$0")/termination-log.sh"
+
 mkdir -p ${PWD}/.terraform
 
 # Decode any *.b64 files
@@ -10,19 +12,15 @@ find . -maxdepth 1 -mindepth 1 -name "*.b64" -exec sh -c "base64 -d {} > \$(base
 
 terraform version
 
-cat > backend.tf <<EOF
-terraform {
-  backend "gcs" {
-    bucket = "${BACKEND_BUCKET}"
-    prefix = "${BACKEND_PREFIX}"
-  }
-}
-EOF
+# Backend block rendered by the operator from the spec.
+echo "${BACKEND_CONFIG}" > backend.tf
 
 tree
 
 terraform init -upgrade=true
-terraform workspace select ${WORKSPACE} || terraform workspace new ${WORKSPACE}
+if [[ -n "${WORKSPACE}" ]]; then
+  terraform workspace select ${WORKSPACE} || terraform workspace new ${WORKSPACE}
+fi
 terraform plan -input=false -out terraform.tfplan
 
 # Write plan to configmap as binary blob.
@@ -43,9 +41,18 @@ EOF
   # Copy plan to backend bucket
   gsutil cp "${tfplan}" "${destPath}"
 
+  # Terraform 0.12+ can render the plan as JSON, publish it next to the plan for the operator to summarize.
+  if terraform show -json "${tfplan}" > "${tfplan}.json" 2>/dev/null; then
+    echo "INFO: Uploading ${tfplan}.json to ${destPath}.json"
+    gsutil cp "${tfplan}.json" "${destPath}.json"
+  fi
+
+  # Hash of the plan file, used to approve the exact plan before it is applied.
+  planHash=$(sha256sum "${tfplan}" | cut -d' ' -f1)
+
   if [[ -n ${POD_NAME+x} ]]; then
-      echo "INFO: Updating pod annotation with path to plan file."
-      PATCH=$(echo "{}" | jq -r -c --arg data "${destPath}" '[{op: "add", path: "/metadata/annotations/terraform-plan", value: $data}]')
+      echo "INFO: Updating pod annotation with path and hash of plan file."
+      PATCH=$(echo "{}" | jq -r -c --arg data "${destPath}" --arg hash "${planHash}" '[{op: "add", path: "/metadata/annotations/terraform-plan", value: $data}, {op: "add", path: "/metadata/annotations/terraform-plan-hash", value: $hash}]')
       kubectl patch pod "${POD_NAME}" --type json -p="${PATCH}"
   else
     echo "ERROR: Missing POD_NAME env var, should have been provided from downward API."
@@ -0,0 +1,35 @@
+#!/usr/bin/env bash
+
+# Sourced by the run-terraform-*.sh scripts.
+# Writes a summary of the Terraform errors to the termination log when the script fails,
+# the operator shows it in the status of the Terraform resource.
+
+TERMINATION_LOG=${TERMINATION_LOG:-/dev/termination-log}
+TERMINATION_LOG_LINES=${TERMINATION_LOG_LINES:-20}
+
+RUN_LOG=$(mktemp)
+exec > >(tee -a "${RUN_LOG}") 2>&1
+TEE_PID=$!
+
+function writeTerminationLog() {
+  local code=$?
+
+  # Flush the output to the run log.
+  exec 1>&- 2>&-
+  wait ${TEE_PID} 2>/dev/null || true
+
+  if [[ ${code} -ne 0 ]]; then
+    # Strip colors from the terraform output.
+    sed 's/\x1b\[[0-9;]*m//g' "${RUN_LOG}" > "${RUN_LOG}.plain"
+
+    if grep -q '^Error' "${RUN_LOG}.plain"; then
+      grep -A 5 '^Error' "${RUN_LOG}.plain" | grep -v '^--$' | tail -n ${TERMINATION_LOG_LINES} > "${TERMINATION_LOG}"
+    else
+      tail -n ${TERMINATION_LOG_LINES} "${RUN_LOG}.plain" > "${TERMINATION_LOG}"
+    fi
+  fi
+
+  rm -f "${RUN_LOG}" "${RUN_LOG}.plain"
+  exit ${code}
+}
+trap writeTerminationLog EXIT
@@ -35,6 +35,10 @@ type output map[string]interface{}
 func tfjson(planfile string) (string, error) {
 	srcPlanFile := planfile
 
+	// Plans from Terraform 0.12+ cannot be read with the legacy API.
+	// The terraform pod publishes the output of `terraform show -json` next to the plan file, use it when available.
+	jsonPlanFile := planfile + ".json"
+
 	if planfile[0:5] == "gs://" {
 		dir, err := ioutil.TempDir("", "tfplan")
 		if err != nil {
@@ -44,12 +48,22 @@ func tfjson(planfile string) (string, error) {
 
 		srcPlanFile = filepath.Join(dir, filepath.Base(planfile))
 
+		// Download JSON plan using gsutil, it only exists for Terraform 0.12+
+		srcJSONPlanFile := srcPlanFile + ".json"
+		if err := getGCSFile(jsonPlanFile, srcJSONPlanFile); err == nil {
+			jsonPlanFile = srcJSONPlanFile
+		}
+
 		// Download plan using gsutil
 		if err := getGCSFile(planfile, srcPlanFile); err != nil {
 			log.Fatal(err)
 		}
 	}
 
+	if data, err := ioutil.ReadFile(jsonPlanFile); err == nil {
+		return string(data), nil
+	}
+
 	f, err := os.Open(srcPlanFile)
 	if err != nil {
 		return "", err
@@ -101,11 +115,27 @@ func convertModuleDiff(out output, diff *terraform.ModuleDiff) {
 func convertInstanceDiff(out output, path []string, diff *terraform.InstanceDiff) {
 	insert(out, path, "destroy", diff.Destroy)
 	insert(out, path, "destroy_tainted", diff.DestroyTainted)
+	insert(out, path, "change_type", convertChangeType(diff.ChangeType()))
 	for k, v := range diff.Attributes {
 		insert(out, path, k, v.New)
 	}
 }
 
+// convertChangeType maps the diff change type to the action name used by the operator.
+func convertChangeType(changeType terraform.DiffChangeType) string {
+	switch changeType {
+	case terraform.DiffCreate:
+		return "create"
+	case terraform.DiffUpdate:
+		return "update"
+	case terraform.DiffDestroy:
+		return "delete"
+	case terraform.DiffDestroyCreate:
+		return "replace"
+	}
+	return ""
+}
+
 func getGCSFile(src, dest string) error {
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -0,0 +1,41 @@
+#!/usr/bin/env bash
+
+# Generates a self-signed certificate for the admission webhooks, stores it in the
+# terraform-operator-admission-tls Secret and applies the webhook configuration.
+
+set -e
+set -o pipefail
+
+NAMESPACE=${NAMESPACE:-metacontroller}
+SERVICE=terraform-operator-admission
+SECRET=terraform-operator-admission-tls
+
+DIR=$(cd "$(dirname "$0")" && pwd)
+TMP=$(mktemp -d)
+trap "rm -rf ${TMP}" EXIT
+
+openssl req -x509 -newkey rsa:2048 -nodes -days 3650 \
+  -keyout ${TMP}/ca.key -out ${TMP}/ca.crt -subj "/CN=terraform-operator-admission-ca"
+
+cat > ${TMP}/csr.conf <<EOT
+[req]
+distinguished_name = req_distinguished_name
+req_extensions = v3_req
+[req_distinguished_name]
+[v3_req]
+keyUsage = digitalSignature, keyEncipherment
+extendedKeyUsage = serverAuth
+subjectAltName = DNS:${SERVICE}.${NAMESPACE}.svc
+EOT
+
+openssl req -newkey rsa:2048 -nodes -keyout ${TMP}/tls.key -out ${TMP}/tls.csr \
+  -subj "/CN=${SERVICE}.${NAMESPACE}.svc" -config ${TMP}/csr.conf
+openssl x509 -req -days 3650 -in ${TMP}/tls.csr -CA ${TMP}/ca.crt -CAkey ${TMP}/ca.key -CAcreateserial \
+  -out ${TMP}/tls.crt -extensions v3_req -extfile ${TMP}/csr.conf
+
+kubectl -n ${NAMESPACE} create secret tls ${SECRET} --cert=${TMP}/tls.crt --key=${TMP}/tls.key --dry-run -o yaml | kubectl apply -f -
+
+CA_BUNDLE=$(base64 < ${TMP}/ca.crt | tr -d '\n')
+sed -e "s|NAMESPACE|${NAMESPACE}|g" -e "s|CA_BUNDLE|${CA_BUNDLE}|g" ${DIR}/terraform-operator-admission.yaml | kubectl apply -f -
+
+echo "INFO: Restart the terraform-operator pod to load the certificate."
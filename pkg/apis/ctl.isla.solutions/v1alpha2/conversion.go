@@ -0,0 +1,477 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConvertFromV1 converts a v1 Terraform resource to the v1alpha2 type of the same kind.
+func ConvertFromV1(in *tfv1.Terraform) (runtime.Object, error) {
+	var out runtime.Object
+	var meta *metav1.ObjectMeta
+	var spec **TerraformSpec
+	var specFrom **TerraformSpecFrom
+	var status *TerraformStatus
+
+	switch in.GetTFKind() {
+	case tfv1.TFKindPlan:
+		obj := &TerraformPlan{}
+		out, meta, spec, specFrom, status = obj, &obj.ObjectMeta, &obj.Spec, &obj.SpecFrom, &obj.Status
+	case tfv1.TFKindApply:
+		obj := &TerraformApply{}
+		out, meta, spec, specFrom, status = obj, &obj.ObjectMeta, &obj.Spec, &obj.SpecFrom, &obj.Status
+	case tfv1.TFKindDestroy:
+		obj := &TerraformDestroy{}
+		out, meta, spec, specFrom, status = obj, &obj.ObjectMeta, &obj.Spec, &obj.SpecFrom, &obj.Status
+	default:
+		return nil, fmt.Errorf("Unsupported kind: %s", in.Kind)
+	}
+
+	in.ObjectMeta.DeepCopyInto(meta)
+
+	if in.Spec != nil {
+		*spec = &TerraformSpec{}
+		if err := Convert_types_TerraformSpec_To_v1alpha2_TerraformSpec(in.Spec, *spec, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.SpecFrom != nil {
+		*specFrom = &TerraformSpecFrom{}
+		if err := Convert_types_TerraformSpecFrom_To_v1alpha2_TerraformSpecFrom(in.SpecFrom, *specFrom, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := Convert_types_TerraformOperatorStatus_To_v1alpha2_TerraformStatus(&in.Status, status, nil); err != nil {
+		return nil, err
+	}
+
+	out.GetObjectKind().SetGroupVersionKind(SchemeGroupVersion.WithKind(in.Kind))
+
+	return out, nil
+}
+
+// ConvertToV1 converts a v1alpha2 TerraformPlan, TerraformApply or TerraformDestroy to the v1 Terraform resource.
+func ConvertToV1(in runtime.Object) (*tfv1.Terraform, error) {
+	var kind tfv1.TFKind
+	var meta *metav1.ObjectMeta
+	var spec *TerraformSpec
+	var specFrom *TerraformSpecFrom
+	var status *TerraformStatus
+
+	switch obj := in.(type) {
+	case *TerraformPlan:
+		kind, meta, spec, specFrom, status = tfv1.TFKindPlan, &obj.ObjectMeta, obj.Spec, obj.SpecFrom, &obj.Status
+	case *TerraformApply:
+		kind, meta, spec, specFrom, status = tfv1.TFKindApply, &obj.ObjectMeta, obj.Spec, obj.SpecFrom, &obj.Status
+	case *TerraformDestroy:
+		kind, meta, spec, specFrom, status = tfv1.TFKindDestroy, &obj.ObjectMeta, obj.Spec, obj.SpecFrom, &obj.Status
+	default:
+		return nil, fmt.Errorf("Unsupported type: %T", in)
+	}
+
+	out := &tfv1.Terraform{}
+	out.APIVersion = fmt.Sprintf("%s/%s", tfv1.Group, tfv1.Version)
+	out.Kind = string(kind)
+	meta.DeepCopyInto(&out.ObjectMeta)
+
+	if spec != nil {
+		out.Spec = &tfv1.TerraformSpec{}
+		if err := Convert_v1alpha2_TerraformSpec_To_types_TerraformSpec(spec, out.Spec, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if specFrom != nil {
+		out.SpecFrom = &tfv1.TerraformSpecFrom{}
+		if err := Convert_v1alpha2_TerraformSpecFrom_To_types_TerraformSpecFrom(specFrom, out.SpecFrom, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := Convert_v1alpha2_TerraformStatus_To_types_TerraformOperatorStatus(status, &out.Status, nil); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Convert_v1alpha2_TerraformSpec_To_types_TerraformSpec converts the list fields to the v1 pointers and the timeout to a duration string.
+func Convert_v1alpha2_TerraformSpec_To_types_TerraformSpec(in *TerraformSpec, out *tfv1.TerraformSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_TerraformSpec_To_types_TerraformSpec(in, out, s); err != nil {
+		return err
+	}
+
+	if in.ProviderConfig != nil {
+		providerConfig := make([]tfv1.TerraformSpecProviderConfig, len(in.ProviderConfig))
+		for i := range in.ProviderConfig {
+			if err := Convert_v1alpha2_TerraformSpecProviderConfig_To_types_TerraformSpecProviderConfig(&in.ProviderConfig[i], &providerConfig[i], s); err != nil {
+				return err
+			}
+		}
+		out.ProviderConfig = &providerConfig
+	}
+
+	if in.TFInputs != nil {
+		tfInputs := make([]tfv1.TerraformConfigInputs, len(in.TFInputs))
+		for i := range in.TFInputs {
+			if err := Convert_v1alpha2_TerraformConfigInputs_To_types_TerraformConfigInputs(&in.TFInputs[i], &tfInputs[i], s); err != nil {
+				return err
+			}
+		}
+		out.TFInputs = &tfInputs
+	}
+
+	if in.TFVars != nil {
+		tfVars := make([]tfv1.TFVar, len(in.TFVars))
+		for i := range in.TFVars {
+			if err := Convert_v1alpha2_TFVar_To_types_TFVar(&in.TFVars[i], &tfVars[i], s); err != nil {
+				return err
+			}
+		}
+		out.TFVars = &tfVars
+	}
+
+	if in.TFVarsFrom != nil {
+		tfVarsFrom := make([]tfv1.TerraformConfigVarsFrom, len(in.TFVarsFrom))
+		for i := range in.TFVarsFrom {
+			if err := Convert_v1alpha2_TerraformConfigVarsFrom_To_types_TerraformConfigVarsFrom(&in.TFVarsFrom[i], &tfVarsFrom[i], s); err != nil {
+				return err
+			}
+		}
+		out.TFVarsFrom = &tfVarsFrom
+	}
+
+	out.Timeout = formatDuration(in.Timeout)
+
+	return nil
+}
+
+// Convert_types_TerraformSpec_To_v1alpha2_TerraformSpec converts the v1 list pointers to lists and parses the timeout.
+func Convert_types_TerraformSpec_To_v1alpha2_TerraformSpec(in *tfv1.TerraformSpec, out *TerraformSpec, s conversion.Scope) error {
+	if err := autoConvert_types_TerraformSpec_To_v1alpha2_TerraformSpec(in, out, s); err != nil {
+		return err
+	}
+
+	if in.ProviderConfig != nil {
+		out.ProviderConfig = make([]TerraformSpecProviderConfig, len(*in.ProviderConfig))
+		for i := range *in.ProviderConfig {
+			if err := Convert_types_TerraformSpecProviderConfig_To_v1alpha2_TerraformSpecProviderConfig(&(*in.ProviderConfig)[i], &out.ProviderConfig[i], s); err != nil {
+				return err
+			}
+		}
+	}
+
+	if in.TFInputs != nil {
+		out.TFInputs = make([]TerraformConfigInputs, len(*in.TFInputs))
+		for i := range *in.TFInputs {
+			if err := Convert_types_TerraformConfigInputs_To_v1alpha2_TerraformConfigInputs(&(*in.TFInputs)[i], &out.TFInputs[i], s); err != nil {
+				return err
+			}
+		}
+	}
+
+	if in.TFVars != nil {
+		out.TFVars = make([]TFVar, len(*in.TFVars))
+		for i := range *in.TFVars {
+			if err := Convert_types_TFVar_To_v1alpha2_TFVar(&(*in.TFVars)[i], &out.TFVars[i], s); err != nil {
+				return err
+			}
+		}
+	}
+
+	if in.TFVarsFrom != nil {
+		out.TFVarsFrom = make([]TerraformConfigVarsFrom, len(*in.TFVarsFrom))
+		for i := range *in.TFVarsFrom {
+			if err := Convert_types_TerraformConfigVarsFrom_To_v1alpha2_TerraformConfigVarsFrom(&(*in.TFVarsFrom)[i], &out.TFVarsFrom[i], s); err != nil {
+				return err
+			}
+		}
+	}
+
+	var err error
+	if out.Timeout, err = parseDuration("spec.timeout", in.Timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Convert_v1alpha2_TerraformDriftDetection_To_types_TerraformDriftDetection converts the interval to a duration string.
+func Convert_v1alpha2_TerraformDriftDetection_To_types_TerraformDriftDetection(in *TerraformDriftDetection, out *tfv1.TerraformDriftDetection, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_TerraformDriftDetection_To_types_TerraformDriftDetection(in, out, s); err != nil {
+		return err
+	}
+	if in.Interval.Duration != 0 {
+		out.Interval = formatDuration(&in.Interval)
+	}
+	return nil
+}
+
+// Convert_types_TerraformDriftDetection_To_v1alpha2_TerraformDriftDetection parses the interval.
+func Convert_types_TerraformDriftDetection_To_v1alpha2_TerraformDriftDetection(in *tfv1.TerraformDriftDetection, out *TerraformDriftDetection, s conversion.Scope) error {
+	if err := autoConvert_types_TerraformDriftDetection_To_v1alpha2_TerraformDriftDetection(in, out, s); err != nil {
+		return err
+	}
+	interval, err := parseDuration("spec.driftDetection.interval", in.Interval)
+	if err != nil {
+		return err
+	}
+	if interval != nil {
+		out.Interval = *interval
+	}
+	return nil
+}
+
+// Convert_v1alpha2_TerraformRetryPolicy_To_types_TerraformRetryPolicy converts the max backoff to a duration string.
+func Convert_v1alpha2_TerraformRetryPolicy_To_types_TerraformRetryPolicy(in *TerraformRetryPolicy, out *tfv1.TerraformRetryPolicy, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_TerraformRetryPolicy_To_types_TerraformRetryPolicy(in, out, s); err != nil {
+		return err
+	}
+	out.MaxBackoff = formatDuration(in.MaxBackoff)
+	return nil
+}
+
+// Convert_types_TerraformRetryPolicy_To_v1alpha2_TerraformRetryPolicy parses the max backoff.
+func Convert_types_TerraformRetryPolicy_To_v1alpha2_TerraformRetryPolicy(in *tfv1.TerraformRetryPolicy, out *TerraformRetryPolicy, s conversion.Scope) error {
+	if err := autoConvert_types_TerraformRetryPolicy_To_v1alpha2_TerraformRetryPolicy(in, out, s); err != nil {
+		return err
+	}
+	var err error
+	out.MaxBackoff, err = parseDuration("spec.retryPolicy.maxBackoff", in.MaxBackoff)
+	return err
+}
+
+// Convert_v1alpha2_TerraformDriftStatus_To_types_TerraformDriftStatus converts the check times to RFC3339 strings.
+func Convert_v1alpha2_TerraformDriftStatus_To_types_TerraformDriftStatus(in *TerraformDriftStatus, out *tfv1.TerraformDriftStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_TerraformDriftStatus_To_types_TerraformDriftStatus(in, out, s); err != nil {
+		return err
+	}
+	out.LastCheckedAt = formatTime(in.LastCheckedAt)
+	out.NextCheckAt = formatTime(in.NextCheckAt)
+	return nil
+}
+
+// Convert_types_TerraformDriftStatus_To_v1alpha2_TerraformDriftStatus parses the check times.
+func Convert_types_TerraformDriftStatus_To_v1alpha2_TerraformDriftStatus(in *tfv1.TerraformDriftStatus, out *TerraformDriftStatus, s conversion.Scope) error {
+	if err := autoConvert_types_TerraformDriftStatus_To_v1alpha2_TerraformDriftStatus(in, out, s); err != nil {
+		return err
+	}
+	var err error
+	if out.LastCheckedAt, err = parseTime("status.drift.lastCheckedAt", in.LastCheckedAt); err != nil {
+		return err
+	}
+	if out.NextCheckAt, err = parseTime("status.drift.nextCheckAt", in.NextCheckAt); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_TerraformStatus_To_types_TerraformOperatorStatus converts the status to the v1 status.
+func Convert_v1alpha2_TerraformStatus_To_types_TerraformOperatorStatus(in *TerraformStatus, out *tfv1.TerraformOperatorStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha2_TerraformOperatorStatusSources_To_types_TerraformOperatorStatusSources(&in.Sources, &out.Sources, s); err != nil {
+		return err
+	}
+	out.PodName = in.PodName
+	out.PodStatus = tfv1.PodStatus(in.PodStatus)
+	out.StartedAt = formatTime(in.StartedAt)
+	out.FinishedAt = formatTime(in.FinishedAt)
+	out.Duration = formatStatusDuration(in.Duration)
+	out.TFPlan = in.PlanFile
+	out.TFPlanHash = in.PlanHash
+	if in.PlanDiff != nil {
+		out.TFPlanDiff = &tfv1.TerraformPlanFileSummary{}
+		if err := Convert_v1alpha2_TerraformPlanFileSummary_To_types_TerraformPlanFileSummary(in.PlanDiff, out.TFPlanDiff, s); err != nil {
+			return err
+		}
+	}
+	if in.Outputs != nil {
+		outputs := make([]tfv1.TerraformOutputVar, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := Convert_v1alpha2_TerraformOutputVar_To_types_TerraformOutputVar(&in.Outputs[i], &outputs[i], s); err != nil {
+				return err
+			}
+		}
+		out.TFOutput = &outputs
+	}
+	out.TFOutputSecret = in.OutputsSecret
+	out.RetryCount = in.RetryCount
+	out.RetryNextAt = formatTime(in.RetryNextAt)
+	out.Workspace = in.Workspace
+	out.StateFile = in.StateFile
+	if in.Drift != nil {
+		out.Drift = &tfv1.TerraformDriftStatus{}
+		if err := Convert_v1alpha2_TerraformDriftStatus_To_types_TerraformDriftStatus(in.Drift, out.Drift, s); err != nil {
+			return err
+		}
+	}
+	out.LastScheduledRun = formatTime(in.LastScheduledRun)
+	out.NextScheduledRun = formatTime(in.NextScheduledRun)
+	out.RunSpecSig = in.RunSpecSig
+	out.RunPlanFile = in.RunPlanFile
+	out.RunPlanHash = in.RunPlanHash
+	out.RunGeneration = in.RunGeneration
+	out.ObservedGeneration = in.ObservedGeneration
+	out.RunReason = in.RunReason
+	if in.Job != nil {
+		out.Job = &tfv1.TerraformJobStatus{}
+		if err := Convert_v1alpha2_TerraformJobStatus_To_types_TerraformJobStatus(in.Job, out.Job, s); err != nil {
+			return err
+		}
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]tfv1.Condition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := Convert_v1alpha2_Condition_To_types_Condition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Convert_types_TerraformOperatorStatus_To_v1alpha2_TerraformStatus converts the v1 status and parses the times and duration.
+func Convert_types_TerraformOperatorStatus_To_v1alpha2_TerraformStatus(in *tfv1.TerraformOperatorStatus, out *TerraformStatus, s conversion.Scope) error {
+	var err error
+	if err = Convert_types_TerraformOperatorStatusSources_To_v1alpha2_TerraformOperatorStatusSources(&in.Sources, &out.Sources, s); err != nil {
+		return err
+	}
+	out.PodName = in.PodName
+	out.PodStatus = PodStatus(in.PodStatus)
+	if out.StartedAt, err = parseTime("status.startedAt", in.StartedAt); err != nil {
+		return err
+	}
+	if out.FinishedAt, err = parseTime("status.finishedAt", in.FinishedAt); err != nil {
+		return err
+	}
+	if out.Duration, err = parseStatusDuration(in.Duration); err != nil {
+		return err
+	}
+	out.PlanFile = in.TFPlan
+	out.PlanHash = in.TFPlanHash
+	if in.TFPlanDiff != nil {
+		out.PlanDiff = &TerraformPlanFileSummary{}
+		if err = Convert_types_TerraformPlanFileSummary_To_v1alpha2_TerraformPlanFileSummary(in.TFPlanDiff, out.PlanDiff, s); err != nil {
+			return err
+		}
+	}
+	if in.TFOutput != nil {
+		out.Outputs = make([]TerraformOutputVar, len(*in.TFOutput))
+		for i := range *in.TFOutput {
+			if err = Convert_types_TerraformOutputVar_To_v1alpha2_TerraformOutputVar(&(*in.TFOutput)[i], &out.Outputs[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	out.OutputsSecret = in.TFOutputSecret
+	out.RetryCount = in.RetryCount
+	if out.RetryNextAt, err = parseTime("status.retryNextAt", in.RetryNextAt); err != nil {
+		return err
+	}
+	out.Workspace = in.Workspace
+	out.StateFile = in.StateFile
+	if in.Drift != nil {
+		out.Drift = &TerraformDriftStatus{}
+		if err = Convert_types_TerraformDriftStatus_To_v1alpha2_TerraformDriftStatus(in.Drift, out.Drift, s); err != nil {
+			return err
+		}
+	}
+	if out.LastScheduledRun, err = parseTime("status.lastScheduledRun", in.LastScheduledRun); err != nil {
+		return err
+	}
+	if out.NextScheduledRun, err = parseTime("status.nextScheduledRun", in.NextScheduledRun); err != nil {
+		return err
+	}
+	out.RunSpecSig = in.RunSpecSig
+	out.RunPlanFile = in.RunPlanFile
+	out.RunPlanHash = in.RunPlanHash
+	out.RunGeneration = in.RunGeneration
+	out.ObservedGeneration = in.ObservedGeneration
+	out.RunReason = in.RunReason
+	if in.Job != nil {
+		out.Job = &TerraformJobStatus{}
+		if err = Convert_types_TerraformJobStatus_To_v1alpha2_TerraformJobStatus(in.Job, out.Job, s); err != nil {
+			return err
+		}
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]Condition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err = Convert_types_Condition_To_v1alpha2_Condition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseTime parses a v1 RFC3339 timestamp, the empty string is a nil time.
+func parseTime(field, value string) (*metav1.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid '%s': %v", field, err)
+	}
+	return &metav1.Time{Time: t}, nil
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseDuration parses a v1 duration string, the empty string is a nil duration.
+func parseDuration(field, value string) (*metav1.Duration, error) {
+	if value == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid '%s': %v", field, err)
+	}
+	return &metav1.Duration{Duration: d}, nil
+}
+
+func formatDuration(d *metav1.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.Duration.String()
+}
+
+// statusDurationPattern matches the v1 status.duration, the rounded minutes followed by the total seconds of the run.
+var statusDurationPattern = regexp.MustCompile(`^[0-9]+m([0-9]+)s$`)
+
+// parseStatusDuration parses the v1 status.duration, for example 02m150s is 150 seconds.
+// The minutes are only informational, the duration is given by the total seconds.
+func parseStatusDuration(value string) (*metav1.Duration, error) {
+	match := statusDurationPattern.FindStringSubmatch(value)
+	if match == nil {
+		return parseDuration("status.duration", value)
+	}
+	seconds, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid 'status.duration': %v", err)
+	}
+	return &metav1.Duration{Duration: time.Duration(seconds) * time.Second}, nil
+}
+
+// formatStatusDuration formats the duration in the v1 status.duration format.
+func formatStatusDuration(d *metav1.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return fmt.Sprintf("%02.0fm%02.0fs", d.Duration.Minutes(), d.Duration.Seconds())
+}
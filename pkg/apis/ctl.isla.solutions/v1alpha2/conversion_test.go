@@ -0,0 +1,218 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	tfv1 "github.com/danisla/terraform-operator/pkg/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func makeTestTerraform(kind tfv1.TFKind) *tfv1.Terraform {
+	maxAttempts := int32(3)
+	return &tfv1.Terraform{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "ctl.isla.solutions/v1",
+			Kind:       string(kind),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: &tfv1.TerraformSpec{
+			Image:          "gcr.io/cloud-solutions-group/terraform-pod:latest",
+			ProviderConfig: &[]tfv1.TerraformSpecProviderConfig{{Name: "google", SecretName: "tf-provider-google"}},
+			Sources:        []tfv1.TerraformConfigSource{{ConfigMap: &tfv1.TerraformSourceConfigMap{Name: "tf-src", Trigger: true}}},
+			TFInputs:       &[]tfv1.TerraformConfigInputs{{Name: "tf-base", VarMap: []tfv1.VarMapItem{{Source: "a", Dest: "b"}}}},
+			TFVars:         &[]tfv1.TFVar{{Name: "region", Value: "us-central1"}},
+			MaxAttempts:    &maxAttempts,
+			DriftDetection: &tfv1.TerraformDriftDetection{Interval: "1h0m0s", AutoApply: true},
+			Timeout:        "10m0s",
+			RetryPolicy: &tfv1.TerraformRetryPolicy{
+				MaxBackoff: "5m0s",
+				Mode:       tfv1.RetryModeFail,
+				Rules:      []tfv1.TerraformRetryRule{{Pattern: "quota", Action: tfv1.RetryActionRetry}},
+			},
+		},
+		Status: tfv1.TerraformOperatorStatus{
+			PodName:        "test-1",
+			PodStatus:      tfv1.PodStatusPassed,
+			StartedAt:      "2018-10-01T10:00:00Z",
+			FinishedAt:     "2018-10-01T10:02:30Z",
+			Duration:       "02m150s",
+			TFPlan:         "gs://bucket/test.tfplan",
+			TFPlanDiff:     &tfv1.TerraformPlanFileSummary{Added: 1},
+			TFOutput:       &[]tfv1.TerraformOutputVar{{Name: "ip", Type: "string", Value: "10.0.0.1"}},
+			TFOutputSecret: "test-outputs",
+			Drift: &tfv1.TerraformDriftStatus{
+				PodName:       "test-drift-0",
+				PodStatus:     tfv1.PodStatusPassed,
+				LastCheckedAt: "2018-10-01T11:00:00Z",
+			},
+			Conditions: []tfv1.Condition{
+				{Type: tfv1.ConditionReady, Status: tfv1.ConditionTrue, Reason: "Ready"},
+			},
+		},
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	for _, kind := range []tfv1.TFKind{tfv1.TFKindPlan, tfv1.TFKindApply, tfv1.TFKindDestroy} {
+		t.Run(string(kind), func(t *testing.T) {
+			in := makeTestTerraform(kind)
+
+			obj, err := ConvertFromV1(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if gvk := obj.GetObjectKind().GroupVersionKind(); gvk != SchemeGroupVersion.WithKind(string(kind)) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", SchemeGroupVersion.WithKind(string(kind)), gvk)
+			}
+
+			got, err := ConvertToV1(obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(in, got) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", in, got)
+			}
+		})
+	}
+}
+
+func TestConvertFromV1Fields(t *testing.T) {
+	obj, err := ConvertFromV1(makeTestTerraform(tfv1.TFKindApply))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tf := obj.(*TerraformApply)
+
+	tests := []struct {
+		name     string
+		got      interface{}
+		expected interface{}
+	}{
+		{"timeout", tf.Spec.Timeout.Duration, 10 * time.Minute},
+		{"drift interval", tf.Spec.DriftDetection.Interval.Duration, time.Hour},
+		{"max backoff", tf.Spec.RetryPolicy.MaxBackoff.Duration, 5 * time.Minute},
+		{"provider config", tf.Spec.ProviderConfig, []TerraformSpecProviderConfig{{Name: "google", SecretName: "tf-provider-google"}}},
+		{"started at", tf.Status.StartedAt.UTC(), time.Date(2018, 10, 1, 10, 0, 0, 0, time.UTC)},
+		{"duration", tf.Status.Duration.Duration, 150 * time.Second},
+		{"unset time", tf.Status.RetryNextAt, (*metav1.Time)(nil)},
+		{"plan file", tf.Status.PlanFile, "gs://bucket/test.tfplan"},
+		{"outputs", tf.Status.Outputs, []TerraformOutputVar{{Name: "ip", Type: "string", Value: "10.0.0.1"}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.got, tc.expected) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, tc.got)
+			}
+		})
+	}
+}
+
+func TestConvertInvalidTime(t *testing.T) {
+	in := makeTestTerraform(tfv1.TFKindApply)
+	in.Status.StartedAt = "yesterday"
+
+	if _, err := ConvertFromV1(in); err == nil {
+		t.Errorf("expected error for invalid status.startedAt")
+	}
+}
+
+// The CRDs serve both versions from the same stored object, so the v1 JSON must decode as v1alpha2 and back.
+func TestJSONCompatible(t *testing.T) {
+	in := makeTestTerraform(tfv1.TFKindApply)
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tf TerraformApply
+	if err := json.Unmarshal(data, &tf); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err = json.Marshal(&tf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got tfv1.Terraform
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(in.Spec, got.Spec) {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", in.Spec, got.Spec)
+	}
+	if in.Status.Duration != got.Status.Duration || in.Status.StartedAt != got.Status.StartedAt {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", in.Status, got.Status)
+	}
+}
+
+// Objects stored by the operator are read with the v1alpha2 clientset without conversion.
+func TestDecodeV1Stored(t *testing.T) {
+	stored := `{
+		"apiVersion": "ctl.isla.solutions/v1",
+		"kind": "TerraformApply",
+		"metadata": {"name": "test", "namespace": "default"},
+		"spec": {"timeout": "10m0s", "sources": [{"embedded": "data"}]},
+		"status": {
+			"podName": "test-tfapply-0",
+			"podStatus": "COMPLETED",
+			"startedAt": "2018-10-01T10:00:00Z",
+			"finishedAt": "2018-10-01T10:02:30Z",
+			"duration": "02m150s"
+		}
+	}`
+
+	var tf TerraformApply
+	if err := json.Unmarshal([]byte(stored), &tf); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		got      interface{}
+		expected interface{}
+	}{
+		{"timeout", tf.Spec.Timeout.Duration, 10 * time.Minute},
+		{"finished at", tf.Status.FinishedAt.UTC(), time.Date(2018, 10, 1, 10, 2, 30, 0, time.UTC)},
+		{"duration", tf.Status.Duration.Duration, 150 * time.Second},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.got, tc.expected) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, tc.got)
+			}
+		})
+	}
+
+	v1, err := ConvertToV1(&tf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v1.Status.Duration != "02m150s" {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", "02m150s", v1.Status.Duration)
+	}
+
+	data, err := json.Marshal(&tf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got tfv1.Terraform
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Duration != "02m150s" {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", "02m150s", got.Status.Duration)
+	}
+}
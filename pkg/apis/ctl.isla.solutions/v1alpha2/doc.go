@@ -0,0 +1,8 @@
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/danisla/terraform-operator/pkg/types
+// +groupName=ctl.isla.solutions
+// +groupGoName=Ctl
+
+// Package v1alpha2 is the v1alpha2 version of the Terraform API.
+// The resources are served from the same CRDs as the v1 types in pkg/types, with typed timestamps and durations.
+package v1alpha2
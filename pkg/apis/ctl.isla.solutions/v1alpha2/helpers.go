@@ -0,0 +1,41 @@
+package v1alpha2
+
+import "encoding/json"
+
+// GetCondition returns the condition of the given type from the status, or nil if it was not found.
+func (s *TerraformStatus) GetCondition(conditionType ConditionType) *Condition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == conditionType {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes the status with the duration in the v1 status.duration format.
+// The CRDs store both versions as v1 without conversion, the v1alpha2 JSON is the stored v1 JSON.
+func (s *TerraformStatus) UnmarshalJSON(data []byte) error {
+	type status TerraformStatus
+	aux := struct {
+		*status
+		Duration string `json:"duration,omitempty"`
+	}{status: (*status)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	duration, err := parseStatusDuration(aux.Duration)
+	if err != nil {
+		return err
+	}
+	s.Duration = duration
+	return nil
+}
+
+// MarshalJSON encodes the status with the duration in the v1 status.duration format.
+func (s TerraformStatus) MarshalJSON() ([]byte, error) {
+	type status TerraformStatus
+	return json.Marshal(struct {
+		status
+		Duration string `json:"duration,omitempty"`
+	}{status(s), formatStatusDuration(s.Duration)})
+}
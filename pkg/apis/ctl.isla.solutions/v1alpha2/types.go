@@ -0,0 +1,381 @@
+package v1alpha2
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +resourceName=terraformplans
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformPlan runs terraform plan and saves the plan file for a TerraformApply.
+type TerraformPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec     *TerraformSpec     `json:"spec,omitempty"`
+	SpecFrom *TerraformSpecFrom `json:"specFrom,omitempty"`
+	Status   TerraformStatus    `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformPlanList is a list of TerraformPlan resources.
+type TerraformPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TerraformPlan `json:"items"`
+}
+
+// +genclient
+// +resourceName=terraformapplys
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformApply runs terraform apply and publishes the outputs.
+type TerraformApply struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec     *TerraformSpec     `json:"spec,omitempty"`
+	SpecFrom *TerraformSpecFrom `json:"specFrom,omitempty"`
+	Status   TerraformStatus    `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformApplyList is a list of TerraformApply resources.
+type TerraformApplyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TerraformApply `json:"items"`
+}
+
+// +genclient
+// +resourceName=terraformdestroys
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformDestroy runs terraform destroy.
+type TerraformDestroy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec     *TerraformSpec     `json:"spec,omitempty"`
+	SpecFrom *TerraformSpecFrom `json:"specFrom,omitempty"`
+	Status   TerraformStatus    `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformDestroyList is a list of TerraformDestroy resources.
+type TerraformDestroyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TerraformDestroy `json:"items"`
+}
+
+// TerraformSpec is the spec shared by all of the Terraform kinds.
+type TerraformSpec struct {
+	Image             string                        `json:"image,omitempty"`
+	ImagePullPolicy   corev1.PullPolicy             `json:"imagePullPolicy,omitempty"`
+	BackendBucket     string                        `json:"backendBucket,omitempty"`
+	BackendPrefix     string                        `json:"backendPrefix,omitempty"`
+	Backend           *TerraformBackend             `json:"backend,omitempty"`
+	ProviderConfig    []TerraformSpecProviderConfig `json:"providerConfig,omitempty"`
+	Sources           []TerraformConfigSource       `json:"sources,omitempty"`
+	TFPlan            string                        `json:"tfplan,omitempty"`
+	Approval          *TerraformApproval            `json:"approval,omitempty"`
+	Policies          []TerraformPolicySource       `json:"policies,omitempty"`
+	TFInputs          []TerraformConfigInputs       `json:"tfinputs,omitempty"`
+	TFVars            []TFVar                       `json:"tfvars,omitempty"`
+	TFVarsFrom        []TerraformConfigVarsFrom     `json:"tfvarsFrom,omitempty"`
+	MaxAttempts       *int32                        `json:"maxAttempts,omitempty"`
+	DriftDetection    *TerraformDriftDetection      `json:"driftDetection,omitempty"`
+	Schedule          string                        `json:"schedule,omitempty"`
+	DestroyOnDelete   bool                          `json:"destroyOnDelete,omitempty"`
+	Job               *TerraformJob                 `json:"job,omitempty"`
+	PodTemplate       *corev1.PodTemplateSpec       `json:"podTemplate,omitempty"`
+	Timeout           *metav1.Duration              `json:"timeout,omitempty"`
+	RetryPolicy       *TerraformRetryPolicy         `json:"retryPolicy,omitempty"`
+	OutputsSecretOnly bool                          `json:"outputsSecretOnly,omitempty"`
+}
+
+// TerraformSpecFrom copies the spec from another Terraform resource.
+type TerraformSpecFrom struct {
+	TFPlan       string `json:"tfplan,omitempty"`
+	TFApply      string `json:"tfapply,omitempty"`
+	TFDestroy    string `json:"tfdestroy,omitempty"`
+	WaitForReady bool   `json:"waitForReady,omitempty"`
+}
+
+// TerraformBackendType is the type of Terraform backend used to store the remote state.
+type TerraformBackendType string
+
+// Supported backend types, see: https://www.terraform.io/docs/backends/types/index.html
+const (
+	BackendGCS        TerraformBackendType = "gcs"
+	BackendS3         TerraformBackendType = "s3"
+	BackendAzureRM    TerraformBackendType = "azurerm"
+	BackendKubernetes TerraformBackendType = "kubernetes"
+	BackendHTTP       TerraformBackendType = "http"
+	BackendLocal      TerraformBackendType = "local"
+)
+
+// TerraformBackend is the Terraform backend used to store the remote state.
+type TerraformBackend struct {
+	Type   TerraformBackendType `json:"type,omitempty"`
+	Config map[string]string    `json:"config,omitempty"`
+}
+
+// TerraformSpecProviderConfig references the Secret with the provider credentials.
+type TerraformSpecProviderConfig struct {
+	Name       string `json:"name,omitempty"`
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// TerraformConfigSource is a source of terraform configs, only one field should be set.
+type TerraformConfigSource struct {
+	ConfigMap *TerraformSourceConfigMap `json:"configMap,omitempty"`
+	Embedded  string                    `json:"embedded,omitempty"`
+	GCS       string                    `json:"gcs,omitempty"`
+	TFPlan    string                    `json:"tfplan,omitempty"`
+	TFApply   string                    `json:"tfapply,omitempty"`
+}
+
+// TerraformSourceConfigMap is a ConfigMap source of terraform configs.
+type TerraformSourceConfigMap struct {
+	Name    string `json:"name,omitempty"`
+	Trigger bool   `json:"trigger,omitempty"`
+}
+
+// TerraformApproval requires approval of the plan given by the tfplan field before it is applied.
+type TerraformApproval struct {
+	Required bool   `json:"required,omitempty"`
+	PlanFile string `json:"planFile,omitempty"`
+	PlanHash string `json:"planHash,omitempty"`
+}
+
+// TerraformPolicySource references a ConfigMap where each key is a plan policy document in YAML format.
+type TerraformPolicySource struct {
+	ConfigMap string `json:"configMap,omitempty"`
+}
+
+// TerraformConfigInputs maps the outputs of a TerraformApply to input vars.
+type TerraformConfigInputs struct {
+	Name         string       `json:"name,omitempty"`
+	WaitForReady bool         `json:"waitForReady,omitempty"`
+	VarMap       []VarMapItem `json:"varMap,omitempty"`
+}
+
+// VarMapItem maps an output var to an input var.
+type VarMapItem struct {
+	Source string `json:"source,omitempty"`
+	Dest   string `json:"dest,omitempty"`
+}
+
+// TFVar is a terraform input var.
+type TFVar struct {
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// TerraformConfigVarsFrom copies the vars from another Terraform resource.
+type TerraformConfigVarsFrom struct {
+	TFApply string `json:"tfapply,omitempty"`
+	TFPlan  string `json:"tfplan,omitempty"`
+}
+
+// TerraformDriftDetection periodically runs a plan against the applied workspace to detect drift.
+type TerraformDriftDetection struct {
+	Interval  metav1.Duration `json:"interval,omitempty"`
+	AutoApply bool            `json:"autoApply,omitempty"`
+}
+
+// TerraformJob runs each terraform operation as a batch/v1 Job instead of a Pod.
+type TerraformJob struct {
+	BackoffLimit            *int32 `json:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds   *int64 `json:"activeDeadlineSeconds,omitempty"`
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// RetryMode is what happens when a run fails more than the max attempts.
+type RetryMode string
+
+const (
+	RetryModeContinuous RetryMode = "Continuous"
+	RetryModeFail       RetryMode = "Fail"
+)
+
+// RetryAction is the action of a retry rule matching the error output of a failed run.
+type RetryAction string
+
+const (
+	RetryActionRetry RetryAction = "Retry"
+	RetryActionFail  RetryAction = "Fail"
+)
+
+// TerraformRetryPolicy controls how failed runs are retried.
+type TerraformRetryPolicy struct {
+	MaxAttempts *int32               `json:"maxAttempts,omitempty"`
+	MaxBackoff  *metav1.Duration     `json:"maxBackoff,omitempty"`
+	Mode        RetryMode            `json:"mode,omitempty"`
+	Rules       []TerraformRetryRule `json:"rules,omitempty"`
+}
+
+// TerraformRetryRule matches the error output of a failed run with a regular expression.
+type TerraformRetryRule struct {
+	Pattern string      `json:"pattern"`
+	Action  RetryAction `json:"action"`
+}
+
+// TerraformStatus is the status shared by all of the Terraform kinds.
+type TerraformStatus struct {
+	Sources            TerraformOperatorStatusSources `json:"sources,omitempty"`
+	PodName            string                         `json:"podName,omitempty"`
+	PodStatus          PodStatus                      `json:"podStatus,omitempty"`
+	StartedAt          *metav1.Time                   `json:"startedAt,omitempty"`
+	FinishedAt         *metav1.Time                   `json:"finishedAt,omitempty"`
+	Duration           *metav1.Duration               `json:"duration,omitempty"`
+	PlanFile           string                         `json:"planFile,omitempty"`
+	PlanHash           string                         `json:"planHash,omitempty"`
+	PlanDiff           *TerraformPlanFileSummary      `json:"planDiff,omitempty"`
+	Outputs            []TerraformOutputVar           `json:"outputs,omitempty"`
+	OutputsSecret      string                         `json:"outputsSecret,omitempty"`
+	RetryCount         int32                          `json:"retryCount,omitempty"`
+	RetryNextAt        *metav1.Time                   `json:"retryNextAt,omitempty"`
+	Workspace          string                         `json:"workspace,omitempty"`
+	StateFile          string                         `json:"stateFile,omitempty"`
+	Drift              *TerraformDriftStatus          `json:"drift,omitempty"`
+	LastScheduledRun   *metav1.Time                   `json:"lastScheduledRun,omitempty"`
+	NextScheduledRun   *metav1.Time                   `json:"nextScheduledRun,omitempty"`
+	RunSpecSig         string                         `json:"runSpecSig,omitempty"`
+	RunPlanFile        string                         `json:"runPlanFile,omitempty"`
+	RunPlanHash        string                         `json:"runPlanHash,omitempty"`
+	RunGeneration      int64                          `json:"runGeneration,omitempty"`
+	ObservedGeneration int64                          `json:"observedGeneration,omitempty"`
+	RunReason          string                         `json:"runReason,omitempty"`
+	Job                *TerraformJobStatus            `json:"job,omitempty"`
+	Conditions         []Condition                    `json:"conditions,omitempty"`
+}
+
+// TerraformOperatorStatusSources is the status of the config sources.
+type TerraformOperatorStatusSources struct {
+	ConfigMapHashes    []ConfigMapHash `json:"configMapHashes,omitempty"`
+	EmbeddedConfigMaps []string        `json:"embeddedConfigMaps,omitempty"`
+}
+
+// ConfigMapHash is the hash of a ConfigMap source.
+type ConfigMapHash struct {
+	Name         string `json:"name,omitempty"`
+	Hash         string `json:"hash,omitempty"`
+	PreviousHash string `json:"previousHash,omitempty"`
+}
+
+// PodStatus is the pass/fail status of a run.
+type PodStatus string
+
+const (
+	PodStatusFailed  PodStatus = "FAILED"
+	PodStatusPassed  PodStatus = "COMPLETED"
+	PodStatusRunning PodStatus = "RUNNING"
+	PodStatusUnknown PodStatus = "UNKNOWN"
+)
+
+// TerraformPlanFileSummary summarizes the changes in a terraform plan.
+type TerraformPlanFileSummary struct {
+	Added     int                           `json:"added"`
+	Changed   int                           `json:"changed"`
+	Destroyed int                           `json:"destroyed"`
+	Replaced  int                           `json:"replaced"`
+	Resources []TerraformPlanResourceChange `json:"resources,omitempty"`
+}
+
+// PlanAction is the action terraform will take on a resource.
+type PlanAction string
+
+const (
+	PlanActionCreate  PlanAction = "create"
+	PlanActionUpdate  PlanAction = "update"
+	PlanActionDelete  PlanAction = "delete"
+	PlanActionReplace PlanAction = "replace"
+)
+
+// TerraformPlanResourceChange is the planned change to a single resource.
+type TerraformPlanResourceChange struct {
+	Address    string     `json:"address"`
+	Module     string     `json:"module,omitempty"`
+	Type       string     `json:"type,omitempty"`
+	Action     PlanAction `json:"action"`
+	Attributes []string   `json:"attributes,omitempty"`
+}
+
+// TerraformOutputVar is a terraform output var.
+type TerraformOutputVar struct {
+	Name      string `json:"name,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Hash      string `json:"hash,omitempty"`
+}
+
+// AnnotationOutputsHashSalt is the annotation of the outputs Secret with the salt of the sensitive output hashes in the status.
+const AnnotationOutputsHashSalt = "ctl.isla.solutions/outputs-hash-salt"
+
+// TerraformDriftStatus is the status of the last drift detection plan.
+type TerraformDriftStatus struct {
+	PodName       string                    `json:"podName,omitempty"`
+	PodStatus     PodStatus                 `json:"podStatus,omitempty"`
+	LastCheckedAt *metav1.Time              `json:"lastCheckedAt,omitempty"`
+	NextCheckAt   *metav1.Time              `json:"nextCheckAt,omitempty"`
+	Drifted       bool                      `json:"drifted,omitempty"`
+	PlanDiff      *TerraformPlanFileSummary `json:"planDiff,omitempty"`
+}
+
+// TerraformJobStatus is the status of the Job for the current run.
+type TerraformJobStatus struct {
+	Name       string                 `json:"name,omitempty"`
+	Active     int32                  `json:"active,omitempty"`
+	Succeeded  int32                  `json:"succeeded,omitempty"`
+	Failed     int32                  `json:"failed,omitempty"`
+	Conditions []batchv1.JobCondition `json:"conditions,omitempty"`
+}
+
+// ConditionType is the type of a status condition.
+type ConditionType string
+
+const (
+	ConditionSpecFromReady       ConditionType = "SpecFromReady"
+	ConditionProviderConfigReady ConditionType = "ProviderConfigReady"
+	ConditionConfigSourceReady   ConditionType = "ConfigSourceReady"
+	ConditionInputsReady         ConditionType = "TFInputsReady"
+	ConditionVarsFromReady       ConditionType = "TFVarsFromReady"
+	ConditionPlanReady           ConditionType = "TFPlanReady"
+	ConditionPolicyPassed        ConditionType = "PolicyPassed"
+	ConditionApproved            ConditionType = "Approved"
+	ConditionPodComplete         ConditionType = "TFPodComplete"
+	ConditionReady               ConditionType = "Ready"
+	ConditionDrifted             ConditionType = "Drifted"
+)
+
+// ConditionStatus is the status of a condition.
+type ConditionStatus string
+
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// Condition is a status condition.
+type Condition struct {
+	Type               ConditionType   `json:"type"`
+	Status             ConditionStatus `json:"status"`
+	LastProbeTime      metav1.Time     `json:"lastProbeTime,omitempty"`
+	LastTransitionTime metav1.Time     `json:"lastTransitionTime,omitempty"`
+	Reason             string          `json:"reason,omitempty"`
+	Message            string          `json:"message,omitempty"`
+}
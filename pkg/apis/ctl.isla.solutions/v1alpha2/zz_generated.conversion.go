@@ -0,0 +1,936 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	unsafe "unsafe"
+
+	types "github.com/danisla/terraform-operator/pkg/types"
+	v1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*types.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Condition_To_types_Condition(a.(*Condition), b.(*types.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.Condition)(nil), (*Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_Condition_To_v1alpha2_Condition(a.(*types.Condition), b.(*Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConfigMapHash)(nil), (*types.ConfigMapHash)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ConfigMapHash_To_types_ConfigMapHash(a.(*ConfigMapHash), b.(*types.ConfigMapHash), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.ConfigMapHash)(nil), (*ConfigMapHash)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_ConfigMapHash_To_v1alpha2_ConfigMapHash(a.(*types.ConfigMapHash), b.(*ConfigMapHash), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TFVar)(nil), (*types.TFVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TFVar_To_types_TFVar(a.(*TFVar), b.(*types.TFVar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TFVar)(nil), (*TFVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TFVar_To_v1alpha2_TFVar(a.(*types.TFVar), b.(*TFVar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformApproval)(nil), (*types.TerraformApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformApproval_To_types_TerraformApproval(a.(*TerraformApproval), b.(*types.TerraformApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformApproval)(nil), (*TerraformApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformApproval_To_v1alpha2_TerraformApproval(a.(*types.TerraformApproval), b.(*TerraformApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformBackend)(nil), (*types.TerraformBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformBackend_To_types_TerraformBackend(a.(*TerraformBackend), b.(*types.TerraformBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformBackend)(nil), (*TerraformBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformBackend_To_v1alpha2_TerraformBackend(a.(*types.TerraformBackend), b.(*TerraformBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformConfigInputs)(nil), (*types.TerraformConfigInputs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformConfigInputs_To_types_TerraformConfigInputs(a.(*TerraformConfigInputs), b.(*types.TerraformConfigInputs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformConfigInputs)(nil), (*TerraformConfigInputs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformConfigInputs_To_v1alpha2_TerraformConfigInputs(a.(*types.TerraformConfigInputs), b.(*TerraformConfigInputs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformConfigSource)(nil), (*types.TerraformConfigSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformConfigSource_To_types_TerraformConfigSource(a.(*TerraformConfigSource), b.(*types.TerraformConfigSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformConfigSource)(nil), (*TerraformConfigSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformConfigSource_To_v1alpha2_TerraformConfigSource(a.(*types.TerraformConfigSource), b.(*TerraformConfigSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformConfigVarsFrom)(nil), (*types.TerraformConfigVarsFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformConfigVarsFrom_To_types_TerraformConfigVarsFrom(a.(*TerraformConfigVarsFrom), b.(*types.TerraformConfigVarsFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformConfigVarsFrom)(nil), (*TerraformConfigVarsFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformConfigVarsFrom_To_v1alpha2_TerraformConfigVarsFrom(a.(*types.TerraformConfigVarsFrom), b.(*TerraformConfigVarsFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformDriftDetection)(nil), (*types.TerraformDriftDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformDriftDetection_To_types_TerraformDriftDetection(a.(*TerraformDriftDetection), b.(*types.TerraformDriftDetection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformDriftDetection)(nil), (*TerraformDriftDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformDriftDetection_To_v1alpha2_TerraformDriftDetection(a.(*types.TerraformDriftDetection), b.(*TerraformDriftDetection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformDriftStatus)(nil), (*types.TerraformDriftStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformDriftStatus_To_types_TerraformDriftStatus(a.(*TerraformDriftStatus), b.(*types.TerraformDriftStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformDriftStatus)(nil), (*TerraformDriftStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformDriftStatus_To_v1alpha2_TerraformDriftStatus(a.(*types.TerraformDriftStatus), b.(*TerraformDriftStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformJob)(nil), (*types.TerraformJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformJob_To_types_TerraformJob(a.(*TerraformJob), b.(*types.TerraformJob), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformJob)(nil), (*TerraformJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformJob_To_v1alpha2_TerraformJob(a.(*types.TerraformJob), b.(*TerraformJob), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformJobStatus)(nil), (*types.TerraformJobStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformJobStatus_To_types_TerraformJobStatus(a.(*TerraformJobStatus), b.(*types.TerraformJobStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformJobStatus)(nil), (*TerraformJobStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformJobStatus_To_v1alpha2_TerraformJobStatus(a.(*types.TerraformJobStatus), b.(*TerraformJobStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformOperatorStatusSources)(nil), (*types.TerraformOperatorStatusSources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformOperatorStatusSources_To_types_TerraformOperatorStatusSources(a.(*TerraformOperatorStatusSources), b.(*types.TerraformOperatorStatusSources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformOperatorStatusSources)(nil), (*TerraformOperatorStatusSources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformOperatorStatusSources_To_v1alpha2_TerraformOperatorStatusSources(a.(*types.TerraformOperatorStatusSources), b.(*TerraformOperatorStatusSources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformOutputVar)(nil), (*types.TerraformOutputVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformOutputVar_To_types_TerraformOutputVar(a.(*TerraformOutputVar), b.(*types.TerraformOutputVar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformOutputVar)(nil), (*TerraformOutputVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformOutputVar_To_v1alpha2_TerraformOutputVar(a.(*types.TerraformOutputVar), b.(*TerraformOutputVar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformPlanFileSummary)(nil), (*types.TerraformPlanFileSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformPlanFileSummary_To_types_TerraformPlanFileSummary(a.(*TerraformPlanFileSummary), b.(*types.TerraformPlanFileSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformPlanFileSummary)(nil), (*TerraformPlanFileSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformPlanFileSummary_To_v1alpha2_TerraformPlanFileSummary(a.(*types.TerraformPlanFileSummary), b.(*TerraformPlanFileSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformPlanResourceChange)(nil), (*types.TerraformPlanResourceChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformPlanResourceChange_To_types_TerraformPlanResourceChange(a.(*TerraformPlanResourceChange), b.(*types.TerraformPlanResourceChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformPlanResourceChange)(nil), (*TerraformPlanResourceChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformPlanResourceChange_To_v1alpha2_TerraformPlanResourceChange(a.(*types.TerraformPlanResourceChange), b.(*TerraformPlanResourceChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformPolicySource)(nil), (*types.TerraformPolicySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformPolicySource_To_types_TerraformPolicySource(a.(*TerraformPolicySource), b.(*types.TerraformPolicySource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformPolicySource)(nil), (*TerraformPolicySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformPolicySource_To_v1alpha2_TerraformPolicySource(a.(*types.TerraformPolicySource), b.(*TerraformPolicySource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformRetryPolicy)(nil), (*types.TerraformRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformRetryPolicy_To_types_TerraformRetryPolicy(a.(*TerraformRetryPolicy), b.(*types.TerraformRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformRetryPolicy)(nil), (*TerraformRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformRetryPolicy_To_v1alpha2_TerraformRetryPolicy(a.(*types.TerraformRetryPolicy), b.(*TerraformRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformRetryRule)(nil), (*types.TerraformRetryRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformRetryRule_To_types_TerraformRetryRule(a.(*TerraformRetryRule), b.(*types.TerraformRetryRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformRetryRule)(nil), (*TerraformRetryRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformRetryRule_To_v1alpha2_TerraformRetryRule(a.(*types.TerraformRetryRule), b.(*TerraformRetryRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformSourceConfigMap)(nil), (*types.TerraformSourceConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformSourceConfigMap_To_types_TerraformSourceConfigMap(a.(*TerraformSourceConfigMap), b.(*types.TerraformSourceConfigMap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformSourceConfigMap)(nil), (*TerraformSourceConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformSourceConfigMap_To_v1alpha2_TerraformSourceConfigMap(a.(*types.TerraformSourceConfigMap), b.(*TerraformSourceConfigMap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformSpec)(nil), (*types.TerraformSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformSpec_To_types_TerraformSpec(a.(*TerraformSpec), b.(*types.TerraformSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformSpec)(nil), (*TerraformSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformSpec_To_v1alpha2_TerraformSpec(a.(*types.TerraformSpec), b.(*TerraformSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformSpecFrom)(nil), (*types.TerraformSpecFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformSpecFrom_To_types_TerraformSpecFrom(a.(*TerraformSpecFrom), b.(*types.TerraformSpecFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformSpecFrom)(nil), (*TerraformSpecFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformSpecFrom_To_v1alpha2_TerraformSpecFrom(a.(*types.TerraformSpecFrom), b.(*TerraformSpecFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformSpecProviderConfig)(nil), (*types.TerraformSpecProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformSpecProviderConfig_To_types_TerraformSpecProviderConfig(a.(*TerraformSpecProviderConfig), b.(*types.TerraformSpecProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.TerraformSpecProviderConfig)(nil), (*TerraformSpecProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformSpecProviderConfig_To_v1alpha2_TerraformSpecProviderConfig(a.(*types.TerraformSpecProviderConfig), b.(*TerraformSpecProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VarMapItem)(nil), (*types.VarMapItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VarMapItem_To_types_VarMapItem(a.(*VarMapItem), b.(*types.VarMapItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*types.VarMapItem)(nil), (*VarMapItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_VarMapItem_To_v1alpha2_VarMapItem(a.(*types.VarMapItem), b.(*VarMapItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*types.TerraformDriftDetection)(nil), (*TerraformDriftDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformDriftDetection_To_v1alpha2_TerraformDriftDetection(a.(*types.TerraformDriftDetection), b.(*TerraformDriftDetection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*types.TerraformDriftStatus)(nil), (*TerraformDriftStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformDriftStatus_To_v1alpha2_TerraformDriftStatus(a.(*types.TerraformDriftStatus), b.(*TerraformDriftStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*types.TerraformOperatorStatus)(nil), (*TerraformStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformOperatorStatus_To_v1alpha2_TerraformStatus(a.(*types.TerraformOperatorStatus), b.(*TerraformStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*types.TerraformRetryPolicy)(nil), (*TerraformRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformRetryPolicy_To_v1alpha2_TerraformRetryPolicy(a.(*types.TerraformRetryPolicy), b.(*TerraformRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*types.TerraformSpec)(nil), (*TerraformSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_types_TerraformSpec_To_v1alpha2_TerraformSpec(a.(*types.TerraformSpec), b.(*TerraformSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TerraformDriftDetection)(nil), (*types.TerraformDriftDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformDriftDetection_To_types_TerraformDriftDetection(a.(*TerraformDriftDetection), b.(*types.TerraformDriftDetection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TerraformDriftStatus)(nil), (*types.TerraformDriftStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformDriftStatus_To_types_TerraformDriftStatus(a.(*TerraformDriftStatus), b.(*types.TerraformDriftStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TerraformRetryPolicy)(nil), (*types.TerraformRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformRetryPolicy_To_types_TerraformRetryPolicy(a.(*TerraformRetryPolicy), b.(*types.TerraformRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TerraformSpec)(nil), (*types.TerraformSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformSpec_To_types_TerraformSpec(a.(*TerraformSpec), b.(*types.TerraformSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TerraformStatus)(nil), (*types.TerraformOperatorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerraformStatus_To_types_TerraformOperatorStatus(a.(*TerraformStatus), b.(*types.TerraformOperatorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_Condition_To_types_Condition(in *Condition, out *types.Condition, s conversion.Scope) error {
+	out.Type = types.ConditionType(in.Type)
+	out.Status = types.ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha2_Condition_To_types_Condition is an autogenerated conversion function.
+func Convert_v1alpha2_Condition_To_types_Condition(in *Condition, out *types.Condition, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Condition_To_types_Condition(in, out, s)
+}
+
+func autoConvert_types_Condition_To_v1alpha2_Condition(in *types.Condition, out *Condition, s conversion.Scope) error {
+	out.Type = ConditionType(in.Type)
+	out.Status = ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_types_Condition_To_v1alpha2_Condition is an autogenerated conversion function.
+func Convert_types_Condition_To_v1alpha2_Condition(in *types.Condition, out *Condition, s conversion.Scope) error {
+	return autoConvert_types_Condition_To_v1alpha2_Condition(in, out, s)
+}
+
+func autoConvert_v1alpha2_ConfigMapHash_To_types_ConfigMapHash(in *ConfigMapHash, out *types.ConfigMapHash, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Hash = in.Hash
+	out.PreviousHash = in.PreviousHash
+	return nil
+}
+
+// Convert_v1alpha2_ConfigMapHash_To_types_ConfigMapHash is an autogenerated conversion function.
+func Convert_v1alpha2_ConfigMapHash_To_types_ConfigMapHash(in *ConfigMapHash, out *types.ConfigMapHash, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ConfigMapHash_To_types_ConfigMapHash(in, out, s)
+}
+
+func autoConvert_types_ConfigMapHash_To_v1alpha2_ConfigMapHash(in *types.ConfigMapHash, out *ConfigMapHash, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Hash = in.Hash
+	out.PreviousHash = in.PreviousHash
+	return nil
+}
+
+// Convert_types_ConfigMapHash_To_v1alpha2_ConfigMapHash is an autogenerated conversion function.
+func Convert_types_ConfigMapHash_To_v1alpha2_ConfigMapHash(in *types.ConfigMapHash, out *ConfigMapHash, s conversion.Scope) error {
+	return autoConvert_types_ConfigMapHash_To_v1alpha2_ConfigMapHash(in, out, s)
+}
+
+func autoConvert_v1alpha2_TFVar_To_types_TFVar(in *TFVar, out *types.TFVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1alpha2_TFVar_To_types_TFVar is an autogenerated conversion function.
+func Convert_v1alpha2_TFVar_To_types_TFVar(in *TFVar, out *types.TFVar, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TFVar_To_types_TFVar(in, out, s)
+}
+
+func autoConvert_types_TFVar_To_v1alpha2_TFVar(in *types.TFVar, out *TFVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_types_TFVar_To_v1alpha2_TFVar is an autogenerated conversion function.
+func Convert_types_TFVar_To_v1alpha2_TFVar(in *types.TFVar, out *TFVar, s conversion.Scope) error {
+	return autoConvert_types_TFVar_To_v1alpha2_TFVar(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformApproval_To_types_TerraformApproval(in *TerraformApproval, out *types.TerraformApproval, s conversion.Scope) error {
+	out.Required = in.Required
+	out.PlanFile = in.PlanFile
+	out.PlanHash = in.PlanHash
+	return nil
+}
+
+// Convert_v1alpha2_TerraformApproval_To_types_TerraformApproval is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformApproval_To_types_TerraformApproval(in *TerraformApproval, out *types.TerraformApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformApproval_To_types_TerraformApproval(in, out, s)
+}
+
+func autoConvert_types_TerraformApproval_To_v1alpha2_TerraformApproval(in *types.TerraformApproval, out *TerraformApproval, s conversion.Scope) error {
+	out.Required = in.Required
+	out.PlanFile = in.PlanFile
+	out.PlanHash = in.PlanHash
+	return nil
+}
+
+// Convert_types_TerraformApproval_To_v1alpha2_TerraformApproval is an autogenerated conversion function.
+func Convert_types_TerraformApproval_To_v1alpha2_TerraformApproval(in *types.TerraformApproval, out *TerraformApproval, s conversion.Scope) error {
+	return autoConvert_types_TerraformApproval_To_v1alpha2_TerraformApproval(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformBackend_To_types_TerraformBackend(in *TerraformBackend, out *types.TerraformBackend, s conversion.Scope) error {
+	out.Type = types.TerraformBackendType(in.Type)
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	return nil
+}
+
+// Convert_v1alpha2_TerraformBackend_To_types_TerraformBackend is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformBackend_To_types_TerraformBackend(in *TerraformBackend, out *types.TerraformBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformBackend_To_types_TerraformBackend(in, out, s)
+}
+
+func autoConvert_types_TerraformBackend_To_v1alpha2_TerraformBackend(in *types.TerraformBackend, out *TerraformBackend, s conversion.Scope) error {
+	out.Type = TerraformBackendType(in.Type)
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	return nil
+}
+
+// Convert_types_TerraformBackend_To_v1alpha2_TerraformBackend is an autogenerated conversion function.
+func Convert_types_TerraformBackend_To_v1alpha2_TerraformBackend(in *types.TerraformBackend, out *TerraformBackend, s conversion.Scope) error {
+	return autoConvert_types_TerraformBackend_To_v1alpha2_TerraformBackend(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformConfigInputs_To_types_TerraformConfigInputs(in *TerraformConfigInputs, out *types.TerraformConfigInputs, s conversion.Scope) error {
+	out.Name = in.Name
+	out.WaitForReady = in.WaitForReady
+	out.VarMap = *(*[]types.VarMapItem)(unsafe.Pointer(&in.VarMap))
+	return nil
+}
+
+// Convert_v1alpha2_TerraformConfigInputs_To_types_TerraformConfigInputs is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformConfigInputs_To_types_TerraformConfigInputs(in *TerraformConfigInputs, out *types.TerraformConfigInputs, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformConfigInputs_To_types_TerraformConfigInputs(in, out, s)
+}
+
+func autoConvert_types_TerraformConfigInputs_To_v1alpha2_TerraformConfigInputs(in *types.TerraformConfigInputs, out *TerraformConfigInputs, s conversion.Scope) error {
+	out.Name = in.Name
+	out.WaitForReady = in.WaitForReady
+	out.VarMap = *(*[]VarMapItem)(unsafe.Pointer(&in.VarMap))
+	return nil
+}
+
+// Convert_types_TerraformConfigInputs_To_v1alpha2_TerraformConfigInputs is an autogenerated conversion function.
+func Convert_types_TerraformConfigInputs_To_v1alpha2_TerraformConfigInputs(in *types.TerraformConfigInputs, out *TerraformConfigInputs, s conversion.Scope) error {
+	return autoConvert_types_TerraformConfigInputs_To_v1alpha2_TerraformConfigInputs(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformConfigSource_To_types_TerraformConfigSource(in *TerraformConfigSource, out *types.TerraformConfigSource, s conversion.Scope) error {
+	out.ConfigMap = (*types.TerraformSourceConfigMap)(unsafe.Pointer(in.ConfigMap))
+	out.Embedded = in.Embedded
+	out.GCS = in.GCS
+	out.TFPlan = in.TFPlan
+	out.TFApply = in.TFApply
+	return nil
+}
+
+// Convert_v1alpha2_TerraformConfigSource_To_types_TerraformConfigSource is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformConfigSource_To_types_TerraformConfigSource(in *TerraformConfigSource, out *types.TerraformConfigSource, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformConfigSource_To_types_TerraformConfigSource(in, out, s)
+}
+
+func autoConvert_types_TerraformConfigSource_To_v1alpha2_TerraformConfigSource(in *types.TerraformConfigSource, out *TerraformConfigSource, s conversion.Scope) error {
+	out.ConfigMap = (*TerraformSourceConfigMap)(unsafe.Pointer(in.ConfigMap))
+	out.Embedded = in.Embedded
+	out.GCS = in.GCS
+	out.TFPlan = in.TFPlan
+	out.TFApply = in.TFApply
+	return nil
+}
+
+// Convert_types_TerraformConfigSource_To_v1alpha2_TerraformConfigSource is an autogenerated conversion function.
+func Convert_types_TerraformConfigSource_To_v1alpha2_TerraformConfigSource(in *types.TerraformConfigSource, out *TerraformConfigSource, s conversion.Scope) error {
+	return autoConvert_types_TerraformConfigSource_To_v1alpha2_TerraformConfigSource(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformConfigVarsFrom_To_types_TerraformConfigVarsFrom(in *TerraformConfigVarsFrom, out *types.TerraformConfigVarsFrom, s conversion.Scope) error {
+	out.TFApply = in.TFApply
+	out.TFPlan = in.TFPlan
+	return nil
+}
+
+// Convert_v1alpha2_TerraformConfigVarsFrom_To_types_TerraformConfigVarsFrom is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformConfigVarsFrom_To_types_TerraformConfigVarsFrom(in *TerraformConfigVarsFrom, out *types.TerraformConfigVarsFrom, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformConfigVarsFrom_To_types_TerraformConfigVarsFrom(in, out, s)
+}
+
+func autoConvert_types_TerraformConfigVarsFrom_To_v1alpha2_TerraformConfigVarsFrom(in *types.TerraformConfigVarsFrom, out *TerraformConfigVarsFrom, s conversion.Scope) error {
+	out.TFApply = in.TFApply
+	out.TFPlan = in.TFPlan
+	return nil
+}
+
+// Convert_types_TerraformConfigVarsFrom_To_v1alpha2_TerraformConfigVarsFrom is an autogenerated conversion function.
+func Convert_types_TerraformConfigVarsFrom_To_v1alpha2_TerraformConfigVarsFrom(in *types.TerraformConfigVarsFrom, out *TerraformConfigVarsFrom, s conversion.Scope) error {
+	return autoConvert_types_TerraformConfigVarsFrom_To_v1alpha2_TerraformConfigVarsFrom(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformDriftDetection_To_types_TerraformDriftDetection(in *TerraformDriftDetection, out *types.TerraformDriftDetection, s conversion.Scope) error {
+	// WARNING: in.Interval requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs string)
+	out.AutoApply = in.AutoApply
+	return nil
+}
+
+func autoConvert_types_TerraformDriftDetection_To_v1alpha2_TerraformDriftDetection(in *types.TerraformDriftDetection, out *TerraformDriftDetection, s conversion.Scope) error {
+	// WARNING: in.Interval requires manual conversion: inconvertible types (string vs k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.AutoApply = in.AutoApply
+	return nil
+}
+
+func autoConvert_v1alpha2_TerraformDriftStatus_To_types_TerraformDriftStatus(in *TerraformDriftStatus, out *types.TerraformDriftStatus, s conversion.Scope) error {
+	out.PodName = in.PodName
+	out.PodStatus = types.PodStatus(in.PodStatus)
+	// WARNING: in.LastCheckedAt requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Time vs string)
+	// WARNING: in.NextCheckAt requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Time vs string)
+	out.Drifted = in.Drifted
+	out.PlanDiff = (*types.TerraformPlanFileSummary)(unsafe.Pointer(in.PlanDiff))
+	return nil
+}
+
+func autoConvert_types_TerraformDriftStatus_To_v1alpha2_TerraformDriftStatus(in *types.TerraformDriftStatus, out *TerraformDriftStatus, s conversion.Scope) error {
+	out.PodName = in.PodName
+	out.PodStatus = PodStatus(in.PodStatus)
+	// WARNING: in.LastCheckedAt requires manual conversion: inconvertible types (string vs *k8s.io/apimachinery/pkg/apis/meta/v1.Time)
+	// WARNING: in.NextCheckAt requires manual conversion: inconvertible types (string vs *k8s.io/apimachinery/pkg/apis/meta/v1.Time)
+	out.Drifted = in.Drifted
+	out.PlanDiff = (*TerraformPlanFileSummary)(unsafe.Pointer(in.PlanDiff))
+	return nil
+}
+
+func autoConvert_v1alpha2_TerraformJob_To_types_TerraformJob(in *TerraformJob, out *types.TerraformJob, s conversion.Scope) error {
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	return nil
+}
+
+// Convert_v1alpha2_TerraformJob_To_types_TerraformJob is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformJob_To_types_TerraformJob(in *TerraformJob, out *types.TerraformJob, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformJob_To_types_TerraformJob(in, out, s)
+}
+
+func autoConvert_types_TerraformJob_To_v1alpha2_TerraformJob(in *types.TerraformJob, out *TerraformJob, s conversion.Scope) error {
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	return nil
+}
+
+// Convert_types_TerraformJob_To_v1alpha2_TerraformJob is an autogenerated conversion function.
+func Convert_types_TerraformJob_To_v1alpha2_TerraformJob(in *types.TerraformJob, out *TerraformJob, s conversion.Scope) error {
+	return autoConvert_types_TerraformJob_To_v1alpha2_TerraformJob(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformJobStatus_To_types_TerraformJobStatus(in *TerraformJobStatus, out *types.TerraformJobStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.Conditions = *(*[]v1.JobCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha2_TerraformJobStatus_To_types_TerraformJobStatus is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformJobStatus_To_types_TerraformJobStatus(in *TerraformJobStatus, out *types.TerraformJobStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformJobStatus_To_types_TerraformJobStatus(in, out, s)
+}
+
+func autoConvert_types_TerraformJobStatus_To_v1alpha2_TerraformJobStatus(in *types.TerraformJobStatus, out *TerraformJobStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.Conditions = *(*[]v1.JobCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_types_TerraformJobStatus_To_v1alpha2_TerraformJobStatus is an autogenerated conversion function.
+func Convert_types_TerraformJobStatus_To_v1alpha2_TerraformJobStatus(in *types.TerraformJobStatus, out *TerraformJobStatus, s conversion.Scope) error {
+	return autoConvert_types_TerraformJobStatus_To_v1alpha2_TerraformJobStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformOperatorStatusSources_To_types_TerraformOperatorStatusSources(in *TerraformOperatorStatusSources, out *types.TerraformOperatorStatusSources, s conversion.Scope) error {
+	out.ConfigMapHashes = *(*[]types.ConfigMapHash)(unsafe.Pointer(&in.ConfigMapHashes))
+	out.EmbeddedConfigMaps = *(*types.EmbeddedConfigMaps)(unsafe.Pointer(&in.EmbeddedConfigMaps))
+	return nil
+}
+
+// Convert_v1alpha2_TerraformOperatorStatusSources_To_types_TerraformOperatorStatusSources is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformOperatorStatusSources_To_types_TerraformOperatorStatusSources(in *TerraformOperatorStatusSources, out *types.TerraformOperatorStatusSources, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformOperatorStatusSources_To_types_TerraformOperatorStatusSources(in, out, s)
+}
+
+func autoConvert_types_TerraformOperatorStatusSources_To_v1alpha2_TerraformOperatorStatusSources(in *types.TerraformOperatorStatusSources, out *TerraformOperatorStatusSources, s conversion.Scope) error {
+	out.ConfigMapHashes = *(*[]ConfigMapHash)(unsafe.Pointer(&in.ConfigMapHashes))
+	out.EmbeddedConfigMaps = *(*[]string)(unsafe.Pointer(&in.EmbeddedConfigMaps))
+	return nil
+}
+
+// Convert_types_TerraformOperatorStatusSources_To_v1alpha2_TerraformOperatorStatusSources is an autogenerated conversion function.
+func Convert_types_TerraformOperatorStatusSources_To_v1alpha2_TerraformOperatorStatusSources(in *types.TerraformOperatorStatusSources, out *TerraformOperatorStatusSources, s conversion.Scope) error {
+	return autoConvert_types_TerraformOperatorStatusSources_To_v1alpha2_TerraformOperatorStatusSources(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformOutputVar_To_types_TerraformOutputVar(in *TerraformOutputVar, out *types.TerraformOutputVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Sensitive = in.Sensitive
+	out.Type = in.Type
+	out.Value = in.Value
+	out.Hash = in.Hash
+	return nil
+}
+
+// Convert_v1alpha2_TerraformOutputVar_To_types_TerraformOutputVar is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformOutputVar_To_types_TerraformOutputVar(in *TerraformOutputVar, out *types.TerraformOutputVar, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformOutputVar_To_types_TerraformOutputVar(in, out, s)
+}
+
+func autoConvert_types_TerraformOutputVar_To_v1alpha2_TerraformOutputVar(in *types.TerraformOutputVar, out *TerraformOutputVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Sensitive = in.Sensitive
+	out.Type = in.Type
+	out.Value = in.Value
+	out.Hash = in.Hash
+	return nil
+}
+
+// Convert_types_TerraformOutputVar_To_v1alpha2_TerraformOutputVar is an autogenerated conversion function.
+func Convert_types_TerraformOutputVar_To_v1alpha2_TerraformOutputVar(in *types.TerraformOutputVar, out *TerraformOutputVar, s conversion.Scope) error {
+	return autoConvert_types_TerraformOutputVar_To_v1alpha2_TerraformOutputVar(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformPlanFileSummary_To_types_TerraformPlanFileSummary(in *TerraformPlanFileSummary, out *types.TerraformPlanFileSummary, s conversion.Scope) error {
+	out.Added = in.Added
+	out.Changed = in.Changed
+	out.Destroyed = in.Destroyed
+	out.Replaced = in.Replaced
+	out.Resources = *(*[]types.TerraformPlanResourceChange)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1alpha2_TerraformPlanFileSummary_To_types_TerraformPlanFileSummary is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformPlanFileSummary_To_types_TerraformPlanFileSummary(in *TerraformPlanFileSummary, out *types.TerraformPlanFileSummary, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformPlanFileSummary_To_types_TerraformPlanFileSummary(in, out, s)
+}
+
+func autoConvert_types_TerraformPlanFileSummary_To_v1alpha2_TerraformPlanFileSummary(in *types.TerraformPlanFileSummary, out *TerraformPlanFileSummary, s conversion.Scope) error {
+	out.Added = in.Added
+	out.Changed = in.Changed
+	out.Destroyed = in.Destroyed
+	out.Replaced = in.Replaced
+	out.Resources = *(*[]TerraformPlanResourceChange)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_types_TerraformPlanFileSummary_To_v1alpha2_TerraformPlanFileSummary is an autogenerated conversion function.
+func Convert_types_TerraformPlanFileSummary_To_v1alpha2_TerraformPlanFileSummary(in *types.TerraformPlanFileSummary, out *TerraformPlanFileSummary, s conversion.Scope) error {
+	return autoConvert_types_TerraformPlanFileSummary_To_v1alpha2_TerraformPlanFileSummary(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformPlanResourceChange_To_types_TerraformPlanResourceChange(in *TerraformPlanResourceChange, out *types.TerraformPlanResourceChange, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Module = in.Module
+	out.Type = in.Type
+	out.Action = types.PlanAction(in.Action)
+	out.Attributes = *(*[]string)(unsafe.Pointer(&in.Attributes))
+	return nil
+}
+
+// Convert_v1alpha2_TerraformPlanResourceChange_To_types_TerraformPlanResourceChange is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformPlanResourceChange_To_types_TerraformPlanResourceChange(in *TerraformPlanResourceChange, out *types.TerraformPlanResourceChange, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformPlanResourceChange_To_types_TerraformPlanResourceChange(in, out, s)
+}
+
+func autoConvert_types_TerraformPlanResourceChange_To_v1alpha2_TerraformPlanResourceChange(in *types.TerraformPlanResourceChange, out *TerraformPlanResourceChange, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Module = in.Module
+	out.Type = in.Type
+	out.Action = PlanAction(in.Action)
+	out.Attributes = *(*[]string)(unsafe.Pointer(&in.Attributes))
+	return nil
+}
+
+// Convert_types_TerraformPlanResourceChange_To_v1alpha2_TerraformPlanResourceChange is an autogenerated conversion function.
+func Convert_types_TerraformPlanResourceChange_To_v1alpha2_TerraformPlanResourceChange(in *types.TerraformPlanResourceChange, out *TerraformPlanResourceChange, s conversion.Scope) error {
+	return autoConvert_types_TerraformPlanResourceChange_To_v1alpha2_TerraformPlanResourceChange(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformPolicySource_To_types_TerraformPolicySource(in *TerraformPolicySource, out *types.TerraformPolicySource, s conversion.Scope) error {
+	out.ConfigMap = in.ConfigMap
+	return nil
+}
+
+// Convert_v1alpha2_TerraformPolicySource_To_types_TerraformPolicySource is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformPolicySource_To_types_TerraformPolicySource(in *TerraformPolicySource, out *types.TerraformPolicySource, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformPolicySource_To_types_TerraformPolicySource(in, out, s)
+}
+
+func autoConvert_types_TerraformPolicySource_To_v1alpha2_TerraformPolicySource(in *types.TerraformPolicySource, out *TerraformPolicySource, s conversion.Scope) error {
+	out.ConfigMap = in.ConfigMap
+	return nil
+}
+
+// Convert_types_TerraformPolicySource_To_v1alpha2_TerraformPolicySource is an autogenerated conversion function.
+func Convert_types_TerraformPolicySource_To_v1alpha2_TerraformPolicySource(in *types.TerraformPolicySource, out *TerraformPolicySource, s conversion.Scope) error {
+	return autoConvert_types_TerraformPolicySource_To_v1alpha2_TerraformPolicySource(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformRetryPolicy_To_types_TerraformRetryPolicy(in *TerraformRetryPolicy, out *types.TerraformRetryPolicy, s conversion.Scope) error {
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	// WARNING: in.MaxBackoff requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs string)
+	out.Mode = types.RetryMode(in.Mode)
+	out.Rules = *(*[]types.TerraformRetryRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+func autoConvert_types_TerraformRetryPolicy_To_v1alpha2_TerraformRetryPolicy(in *types.TerraformRetryPolicy, out *TerraformRetryPolicy, s conversion.Scope) error {
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	// WARNING: in.MaxBackoff requires manual conversion: inconvertible types (string vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.Mode = RetryMode(in.Mode)
+	out.Rules = *(*[]TerraformRetryRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+func autoConvert_v1alpha2_TerraformRetryRule_To_types_TerraformRetryRule(in *TerraformRetryRule, out *types.TerraformRetryRule, s conversion.Scope) error {
+	out.Pattern = in.Pattern
+	out.Action = types.RetryAction(in.Action)
+	return nil
+}
+
+// Convert_v1alpha2_TerraformRetryRule_To_types_TerraformRetryRule is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformRetryRule_To_types_TerraformRetryRule(in *TerraformRetryRule, out *types.TerraformRetryRule, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformRetryRule_To_types_TerraformRetryRule(in, out, s)
+}
+
+func autoConvert_types_TerraformRetryRule_To_v1alpha2_TerraformRetryRule(in *types.TerraformRetryRule, out *TerraformRetryRule, s conversion.Scope) error {
+	out.Pattern = in.Pattern
+	out.Action = RetryAction(in.Action)
+	return nil
+}
+
+// Convert_types_TerraformRetryRule_To_v1alpha2_TerraformRetryRule is an autogenerated conversion function.
+func Convert_types_TerraformRetryRule_To_v1alpha2_TerraformRetryRule(in *types.TerraformRetryRule, out *TerraformRetryRule, s conversion.Scope) error {
+	return autoConvert_types_TerraformRetryRule_To_v1alpha2_TerraformRetryRule(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformSourceConfigMap_To_types_TerraformSourceConfigMap(in *TerraformSourceConfigMap, out *types.TerraformSourceConfigMap, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Trigger = in.Trigger
+	return nil
+}
+
+// Convert_v1alpha2_TerraformSourceConfigMap_To_types_TerraformSourceConfigMap is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformSourceConfigMap_To_types_TerraformSourceConfigMap(in *TerraformSourceConfigMap, out *types.TerraformSourceConfigMap, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformSourceConfigMap_To_types_TerraformSourceConfigMap(in, out, s)
+}
+
+func autoConvert_types_TerraformSourceConfigMap_To_v1alpha2_TerraformSourceConfigMap(in *types.TerraformSourceConfigMap, out *TerraformSourceConfigMap, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Trigger = in.Trigger
+	return nil
+}
+
+// Convert_types_TerraformSourceConfigMap_To_v1alpha2_TerraformSourceConfigMap is an autogenerated conversion function.
+func Convert_types_TerraformSourceConfigMap_To_v1alpha2_TerraformSourceConfigMap(in *types.TerraformSourceConfigMap, out *TerraformSourceConfigMap, s conversion.Scope) error {
+	return autoConvert_types_TerraformSourceConfigMap_To_v1alpha2_TerraformSourceConfigMap(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformSpec_To_types_TerraformSpec(in *TerraformSpec, out *types.TerraformSpec, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = corev1.PullPolicy(in.ImagePullPolicy)
+	out.BackendBucket = in.BackendBucket
+	out.BackendPrefix = in.BackendPrefix
+	out.Backend = (*types.TerraformBackend)(unsafe.Pointer(in.Backend))
+	// WARNING: in.ProviderConfig requires manual conversion: inconvertible types ([]github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TerraformSpecProviderConfig vs *[]github.com/danisla/terraform-operator/pkg/types.TerraformSpecProviderConfig)
+	out.Sources = *(*[]types.TerraformConfigSource)(unsafe.Pointer(&in.Sources))
+	out.TFPlan = in.TFPlan
+	out.Approval = (*types.TerraformApproval)(unsafe.Pointer(in.Approval))
+	out.Policies = *(*[]types.TerraformPolicySource)(unsafe.Pointer(&in.Policies))
+	// WARNING: in.TFInputs requires manual conversion: inconvertible types ([]github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TerraformConfigInputs vs *[]github.com/danisla/terraform-operator/pkg/types.TerraformConfigInputs)
+	// WARNING: in.TFVars requires manual conversion: inconvertible types ([]github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TFVar vs *[]github.com/danisla/terraform-operator/pkg/types.TFVar)
+	// WARNING: in.TFVarsFrom requires manual conversion: inconvertible types ([]github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TerraformConfigVarsFrom vs *[]github.com/danisla/terraform-operator/pkg/types.TerraformConfigVarsFrom)
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(types.TerraformDriftDetection)
+		if err := Convert_v1alpha2_TerraformDriftDetection_To_types_TerraformDriftDetection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DriftDetection = nil
+	}
+	out.Schedule = in.Schedule
+	out.DestroyOnDelete = in.DestroyOnDelete
+	out.Job = (*types.TerraformJob)(unsafe.Pointer(in.Job))
+	out.PodTemplate = (*corev1.PodTemplateSpec)(unsafe.Pointer(in.PodTemplate))
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs string)
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(types.TerraformRetryPolicy)
+		if err := Convert_v1alpha2_TerraformRetryPolicy_To_types_TerraformRetryPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RetryPolicy = nil
+	}
+	out.OutputsSecretOnly = in.OutputsSecretOnly
+	return nil
+}
+
+func autoConvert_types_TerraformSpec_To_v1alpha2_TerraformSpec(in *types.TerraformSpec, out *TerraformSpec, s conversion.Scope) error {
+	out.Image = in.Image
+	out.ImagePullPolicy = corev1.PullPolicy(in.ImagePullPolicy)
+	out.BackendBucket = in.BackendBucket
+	out.BackendPrefix = in.BackendPrefix
+	out.Backend = (*TerraformBackend)(unsafe.Pointer(in.Backend))
+	// WARNING: in.ProviderConfig requires manual conversion: inconvertible types (*[]github.com/danisla/terraform-operator/pkg/types.TerraformSpecProviderConfig vs []github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TerraformSpecProviderConfig)
+	out.Sources = *(*[]TerraformConfigSource)(unsafe.Pointer(&in.Sources))
+	out.TFPlan = in.TFPlan
+	out.Approval = (*TerraformApproval)(unsafe.Pointer(in.Approval))
+	out.Policies = *(*[]TerraformPolicySource)(unsafe.Pointer(&in.Policies))
+	// WARNING: in.TFInputs requires manual conversion: inconvertible types (*[]github.com/danisla/terraform-operator/pkg/types.TerraformConfigInputs vs []github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TerraformConfigInputs)
+	// WARNING: in.TFVars requires manual conversion: inconvertible types (*[]github.com/danisla/terraform-operator/pkg/types.TFVar vs []github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TFVar)
+	// WARNING: in.TFVarsFrom requires manual conversion: inconvertible types (*[]github.com/danisla/terraform-operator/pkg/types.TerraformConfigVarsFrom vs []github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2.TerraformConfigVarsFrom)
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(TerraformDriftDetection)
+		if err := Convert_types_TerraformDriftDetection_To_v1alpha2_TerraformDriftDetection(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DriftDetection = nil
+	}
+	out.Schedule = in.Schedule
+	out.DestroyOnDelete = in.DestroyOnDelete
+	out.Job = (*TerraformJob)(unsafe.Pointer(in.Job))
+	out.PodTemplate = (*corev1.PodTemplateSpec)(unsafe.Pointer(in.PodTemplate))
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (string vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(TerraformRetryPolicy)
+		if err := Convert_types_TerraformRetryPolicy_To_v1alpha2_TerraformRetryPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RetryPolicy = nil
+	}
+	out.OutputsSecretOnly = in.OutputsSecretOnly
+	return nil
+}
+
+func autoConvert_v1alpha2_TerraformSpecFrom_To_types_TerraformSpecFrom(in *TerraformSpecFrom, out *types.TerraformSpecFrom, s conversion.Scope) error {
+	out.TFPlan = in.TFPlan
+	out.TFApply = in.TFApply
+	out.TFDestroy = in.TFDestroy
+	out.WaitForReady = in.WaitForReady
+	return nil
+}
+
+// Convert_v1alpha2_TerraformSpecFrom_To_types_TerraformSpecFrom is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformSpecFrom_To_types_TerraformSpecFrom(in *TerraformSpecFrom, out *types.TerraformSpecFrom, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformSpecFrom_To_types_TerraformSpecFrom(in, out, s)
+}
+
+func autoConvert_types_TerraformSpecFrom_To_v1alpha2_TerraformSpecFrom(in *types.TerraformSpecFrom, out *TerraformSpecFrom, s conversion.Scope) error {
+	out.TFPlan = in.TFPlan
+	out.TFApply = in.TFApply
+	out.TFDestroy = in.TFDestroy
+	out.WaitForReady = in.WaitForReady
+	return nil
+}
+
+// Convert_types_TerraformSpecFrom_To_v1alpha2_TerraformSpecFrom is an autogenerated conversion function.
+func Convert_types_TerraformSpecFrom_To_v1alpha2_TerraformSpecFrom(in *types.TerraformSpecFrom, out *TerraformSpecFrom, s conversion.Scope) error {
+	return autoConvert_types_TerraformSpecFrom_To_v1alpha2_TerraformSpecFrom(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerraformSpecProviderConfig_To_types_TerraformSpecProviderConfig(in *TerraformSpecProviderConfig, out *types.TerraformSpecProviderConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha2_TerraformSpecProviderConfig_To_types_TerraformSpecProviderConfig is an autogenerated conversion function.
+func Convert_v1alpha2_TerraformSpecProviderConfig_To_types_TerraformSpecProviderConfig(in *TerraformSpecProviderConfig, out *types.TerraformSpecProviderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerraformSpecProviderConfig_To_types_TerraformSpecProviderConfig(in, out, s)
+}
+
+func autoConvert_types_TerraformSpecProviderConfig_To_v1alpha2_TerraformSpecProviderConfig(in *types.TerraformSpecProviderConfig, out *TerraformSpecProviderConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_types_TerraformSpecProviderConfig_To_v1alpha2_TerraformSpecProviderConfig is an autogenerated conversion function.
+func Convert_types_TerraformSpecProviderConfig_To_v1alpha2_TerraformSpecProviderConfig(in *types.TerraformSpecProviderConfig, out *TerraformSpecProviderConfig, s conversion.Scope) error {
+	return autoConvert_types_TerraformSpecProviderConfig_To_v1alpha2_TerraformSpecProviderConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_VarMapItem_To_types_VarMapItem(in *VarMapItem, out *types.VarMapItem, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Dest = in.Dest
+	return nil
+}
+
+// Convert_v1alpha2_VarMapItem_To_types_VarMapItem is an autogenerated conversion function.
+func Convert_v1alpha2_VarMapItem_To_types_VarMapItem(in *VarMapItem, out *types.VarMapItem, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VarMapItem_To_types_VarMapItem(in, out, s)
+}
+
+func autoConvert_types_VarMapItem_To_v1alpha2_VarMapItem(in *types.VarMapItem, out *VarMapItem, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Dest = in.Dest
+	return nil
+}
+
+// Convert_types_VarMapItem_To_v1alpha2_VarMapItem is an autogenerated conversion function.
+func Convert_types_VarMapItem_To_v1alpha2_VarMapItem(in *types.VarMapItem, out *VarMapItem, s conversion.Scope) error {
+	return autoConvert_types_VarMapItem_To_v1alpha2_VarMapItem(in, out, s)
+}
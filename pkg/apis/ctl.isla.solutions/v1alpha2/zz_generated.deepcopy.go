@@ -0,0 +1,842 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapHash) DeepCopyInto(out *ConfigMapHash) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapHash.
+func (in *ConfigMapHash) DeepCopy() *ConfigMapHash {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapHash)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TFVar) DeepCopyInto(out *TFVar) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TFVar.
+func (in *TFVar) DeepCopy() *TFVar {
+	if in == nil {
+		return nil
+	}
+	out := new(TFVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformApply) DeepCopyInto(out *TerraformApply) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(TerraformSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpecFrom != nil {
+		in, out := &in.SpecFrom, &out.SpecFrom
+		*out = new(TerraformSpecFrom)
+		**out = **in
+	}
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformApply.
+func (in *TerraformApply) DeepCopy() *TerraformApply {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformApply)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformApply) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformApplyList) DeepCopyInto(out *TerraformApplyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TerraformApply, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformApplyList.
+func (in *TerraformApplyList) DeepCopy() *TerraformApplyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformApplyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformApplyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformApproval) DeepCopyInto(out *TerraformApproval) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformApproval.
+func (in *TerraformApproval) DeepCopy() *TerraformApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformBackend) DeepCopyInto(out *TerraformBackend) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformBackend.
+func (in *TerraformBackend) DeepCopy() *TerraformBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformConfigInputs) DeepCopyInto(out *TerraformConfigInputs) {
+	*out = *in
+	if in.VarMap != nil {
+		in, out := &in.VarMap, &out.VarMap
+		*out = make([]VarMapItem, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformConfigInputs.
+func (in *TerraformConfigInputs) DeepCopy() *TerraformConfigInputs {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformConfigInputs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformConfigSource) DeepCopyInto(out *TerraformConfigSource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(TerraformSourceConfigMap)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformConfigSource.
+func (in *TerraformConfigSource) DeepCopy() *TerraformConfigSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformConfigSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformConfigVarsFrom) DeepCopyInto(out *TerraformConfigVarsFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformConfigVarsFrom.
+func (in *TerraformConfigVarsFrom) DeepCopy() *TerraformConfigVarsFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformConfigVarsFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformDestroy) DeepCopyInto(out *TerraformDestroy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(TerraformSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpecFrom != nil {
+		in, out := &in.SpecFrom, &out.SpecFrom
+		*out = new(TerraformSpecFrom)
+		**out = **in
+	}
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformDestroy.
+func (in *TerraformDestroy) DeepCopy() *TerraformDestroy {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformDestroy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformDestroy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformDestroyList) DeepCopyInto(out *TerraformDestroyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TerraformDestroy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformDestroyList.
+func (in *TerraformDestroyList) DeepCopy() *TerraformDestroyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformDestroyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformDestroyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformDriftDetection) DeepCopyInto(out *TerraformDriftDetection) {
+	*out = *in
+	out.Interval = in.Interval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformDriftDetection.
+func (in *TerraformDriftDetection) DeepCopy() *TerraformDriftDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformDriftDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformDriftStatus) DeepCopyInto(out *TerraformDriftStatus) {
+	*out = *in
+	if in.LastCheckedAt != nil {
+		in, out := &in.LastCheckedAt, &out.LastCheckedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextCheckAt != nil {
+		in, out := &in.NextCheckAt, &out.NextCheckAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PlanDiff != nil {
+		in, out := &in.PlanDiff, &out.PlanDiff
+		*out = new(TerraformPlanFileSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformDriftStatus.
+func (in *TerraformDriftStatus) DeepCopy() *TerraformDriftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformDriftStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformJob) DeepCopyInto(out *TerraformJob) {
+	*out = *in
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformJob.
+func (in *TerraformJob) DeepCopy() *TerraformJob {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformJobStatus) DeepCopyInto(out *TerraformJobStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.JobCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformJobStatus.
+func (in *TerraformJobStatus) DeepCopy() *TerraformJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformOperatorStatusSources) DeepCopyInto(out *TerraformOperatorStatusSources) {
+	*out = *in
+	if in.ConfigMapHashes != nil {
+		in, out := &in.ConfigMapHashes, &out.ConfigMapHashes
+		*out = make([]ConfigMapHash, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmbeddedConfigMaps != nil {
+		in, out := &in.EmbeddedConfigMaps, &out.EmbeddedConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformOperatorStatusSources.
+func (in *TerraformOperatorStatusSources) DeepCopy() *TerraformOperatorStatusSources {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformOperatorStatusSources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformOutputVar) DeepCopyInto(out *TerraformOutputVar) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformOutputVar.
+func (in *TerraformOutputVar) DeepCopy() *TerraformOutputVar {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformOutputVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformPlan) DeepCopyInto(out *TerraformPlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(TerraformSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpecFrom != nil {
+		in, out := &in.SpecFrom, &out.SpecFrom
+		*out = new(TerraformSpecFrom)
+		**out = **in
+	}
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformPlan.
+func (in *TerraformPlan) DeepCopy() *TerraformPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformPlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformPlanFileSummary) DeepCopyInto(out *TerraformPlanFileSummary) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]TerraformPlanResourceChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformPlanFileSummary.
+func (in *TerraformPlanFileSummary) DeepCopy() *TerraformPlanFileSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformPlanFileSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformPlanList) DeepCopyInto(out *TerraformPlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TerraformPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformPlanList.
+func (in *TerraformPlanList) DeepCopy() *TerraformPlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformPlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformPlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformPlanResourceChange) DeepCopyInto(out *TerraformPlanResourceChange) {
+	*out = *in
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformPlanResourceChange.
+func (in *TerraformPlanResourceChange) DeepCopy() *TerraformPlanResourceChange {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformPlanResourceChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformPolicySource) DeepCopyInto(out *TerraformPolicySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformPolicySource.
+func (in *TerraformPolicySource) DeepCopy() *TerraformPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRetryPolicy) DeepCopyInto(out *TerraformRetryPolicy) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]TerraformRetryRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRetryPolicy.
+func (in *TerraformRetryPolicy) DeepCopy() *TerraformRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRetryRule) DeepCopyInto(out *TerraformRetryRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRetryRule.
+func (in *TerraformRetryRule) DeepCopy() *TerraformRetryRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRetryRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformSourceConfigMap) DeepCopyInto(out *TerraformSourceConfigMap) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformSourceConfigMap.
+func (in *TerraformSourceConfigMap) DeepCopy() *TerraformSourceConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformSourceConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformSpec) DeepCopyInto(out *TerraformSpec) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(TerraformBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = make([]TerraformSpecProviderConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]TerraformConfigSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(TerraformApproval)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]TerraformPolicySource, len(*in))
+		copy(*out, *in)
+	}
+	if in.TFInputs != nil {
+		in, out := &in.TFInputs, &out.TFInputs
+		*out = make([]TerraformConfigInputs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TFVars != nil {
+		in, out := &in.TFVars, &out.TFVars
+		*out = make([]TFVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.TFVarsFrom != nil {
+		in, out := &in.TFVarsFrom, &out.TFVarsFrom
+		*out = make([]TerraformConfigVarsFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(TerraformDriftDetection)
+		**out = **in
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(TerraformJob)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(corev1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(TerraformRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformSpec.
+func (in *TerraformSpec) DeepCopy() *TerraformSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformSpecFrom) DeepCopyInto(out *TerraformSpecFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformSpecFrom.
+func (in *TerraformSpecFrom) DeepCopy() *TerraformSpecFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformSpecFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformSpecProviderConfig) DeepCopyInto(out *TerraformSpecProviderConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformSpecProviderConfig.
+func (in *TerraformSpecProviderConfig) DeepCopy() *TerraformSpecProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformSpecProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStatus) DeepCopyInto(out *TerraformStatus) {
+	*out = *in
+	in.Sources.DeepCopyInto(&out.Sources)
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PlanDiff != nil {
+		in, out := &in.PlanDiff, &out.PlanDiff
+		*out = new(TerraformPlanFileSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]TerraformOutputVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetryNextAt != nil {
+		in, out := &in.RetryNextAt, &out.RetryNextAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(TerraformDriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastScheduledRun != nil {
+		in, out := &in.LastScheduledRun, &out.LastScheduledRun
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduledRun != nil {
+		in, out := &in.NextScheduledRun, &out.NextScheduledRun
+		*out = (*in).DeepCopy()
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(TerraformJobStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStatus.
+func (in *TerraformStatus) DeepCopy() *TerraformStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VarMapItem) DeepCopyInto(out *VarMapItem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VarMapItem.
+func (in *VarMapItem) DeepCopy() *VarMapItem {
+	if in == nil {
+		return nil
+	}
+	out := new(VarMapItem)
+	in.DeepCopyInto(out)
+	return out
+}
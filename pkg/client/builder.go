@@ -0,0 +1,238 @@
+package client
+
+import (
+	"time"
+
+	tfv1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpecBuilder builds a TerraformSpec, the zero value fields are defaulted by the operator.
+type SpecBuilder struct {
+	spec tfv1alpha2.TerraformSpec
+}
+
+// NewSpec returns a builder for an empty TerraformSpec.
+func NewSpec() *SpecBuilder {
+	return &SpecBuilder{}
+}
+
+// Image sets the terraform pod image and pull policy.
+func (b *SpecBuilder) Image(image string, pullPolicy corev1.PullPolicy) *SpecBuilder {
+	b.spec.Image = image
+	b.spec.ImagePullPolicy = pullPolicy
+	return b
+}
+
+// GCSBackend sets the bucket and prefix of the GCS backend.
+func (b *SpecBuilder) GCSBackend(bucket, prefix string) *SpecBuilder {
+	b.spec.BackendBucket = bucket
+	b.spec.BackendPrefix = prefix
+	return b
+}
+
+// Backend sets the terraform backend type and config.
+func (b *SpecBuilder) Backend(backendType tfv1alpha2.TerraformBackendType, config map[string]string) *SpecBuilder {
+	b.spec.Backend = &tfv1alpha2.TerraformBackend{
+		Type:   backendType,
+		Config: config,
+	}
+	return b
+}
+
+// ProviderConfig adds the Secret with the credentials of the named provider.
+func (b *SpecBuilder) ProviderConfig(name, secretName string) *SpecBuilder {
+	b.spec.ProviderConfig = append(b.spec.ProviderConfig, tfv1alpha2.TerraformSpecProviderConfig{
+		Name:       name,
+		SecretName: secretName,
+	})
+	return b
+}
+
+// ConfigMapSource adds a ConfigMap source, with trigger set, changes to the ConfigMap start a new run.
+func (b *SpecBuilder) ConfigMapSource(name string, trigger bool) *SpecBuilder {
+	b.spec.Sources = append(b.spec.Sources, tfv1alpha2.TerraformConfigSource{
+		ConfigMap: &tfv1alpha2.TerraformSourceConfigMap{
+			Name:    name,
+			Trigger: trigger,
+		},
+	})
+	return b
+}
+
+// EmbeddedSource adds a source with the terraform config inline.
+func (b *SpecBuilder) EmbeddedSource(config string) *SpecBuilder {
+	b.spec.Sources = append(b.spec.Sources, tfv1alpha2.TerraformConfigSource{Embedded: config})
+	return b
+}
+
+// GCSSource adds a source from a tarball in GCS.
+func (b *SpecBuilder) GCSSource(url string) *SpecBuilder {
+	b.spec.Sources = append(b.spec.Sources, tfv1alpha2.TerraformConfigSource{GCS: url})
+	return b
+}
+
+// TFApplySource adds the sources of the named TerraformApply.
+func (b *SpecBuilder) TFApplySource(name string) *SpecBuilder {
+	b.spec.Sources = append(b.spec.Sources, tfv1alpha2.TerraformConfigSource{TFApply: name})
+	return b
+}
+
+// TFPlanSource adds the sources of the named TerraformPlan.
+func (b *SpecBuilder) TFPlanSource(name string) *SpecBuilder {
+	b.spec.Sources = append(b.spec.Sources, tfv1alpha2.TerraformConfigSource{TFPlan: name})
+	return b
+}
+
+// TFVar adds an input var.
+func (b *SpecBuilder) TFVar(name, value string) *SpecBuilder {
+	b.spec.TFVars = append(b.spec.TFVars, tfv1alpha2.TFVar{
+		Name:  name,
+		Value: value,
+	})
+	return b
+}
+
+// TFVars adds the input vars from the map, ordered by name.
+func (b *SpecBuilder) TFVars(vars map[string]string) *SpecBuilder {
+	for _, k := range sortedKeys(vars) {
+		b.TFVar(k, vars[k])
+	}
+	return b
+}
+
+// TFVarsFromTFApply copies the vars from the named TerraformApply.
+func (b *SpecBuilder) TFVarsFromTFApply(name string) *SpecBuilder {
+	b.spec.TFVarsFrom = append(b.spec.TFVarsFrom, tfv1alpha2.TerraformConfigVarsFrom{TFApply: name})
+	return b
+}
+
+// TFVarsFromTFPlan copies the vars from the named TerraformPlan.
+func (b *SpecBuilder) TFVarsFromTFPlan(name string) *SpecBuilder {
+	b.spec.TFVarsFrom = append(b.spec.TFVarsFrom, tfv1alpha2.TerraformConfigVarsFrom{TFPlan: name})
+	return b
+}
+
+// TFInput maps the outputs of the named TerraformApply to input vars, the varMap keys are the output names.
+func (b *SpecBuilder) TFInput(name string, waitForReady bool, varMap map[string]string) *SpecBuilder {
+	input := tfv1alpha2.TerraformConfigInputs{
+		Name:         name,
+		WaitForReady: waitForReady,
+	}
+	for _, k := range sortedKeys(varMap) {
+		input.VarMap = append(input.VarMap, tfv1alpha2.VarMapItem{
+			Source: k,
+			Dest:   varMap[k],
+		})
+	}
+	b.spec.TFInputs = append(b.spec.TFInputs, input)
+	return b
+}
+
+// TFPlan sets the plan file to apply, with requireApproval the apply waits for the plan to be approved.
+func (b *SpecBuilder) TFPlan(planFile string, requireApproval bool) *SpecBuilder {
+	b.spec.TFPlan = planFile
+	if requireApproval {
+		b.spec.Approval = &tfv1alpha2.TerraformApproval{Required: true}
+	}
+	return b
+}
+
+// PolicyConfigMap adds a ConfigMap of plan policies.
+func (b *SpecBuilder) PolicyConfigMap(name string) *SpecBuilder {
+	b.spec.Policies = append(b.spec.Policies, tfv1alpha2.TerraformPolicySource{ConfigMap: name})
+	return b
+}
+
+// MaxAttempts sets the number of attempts before a run fails.
+func (b *SpecBuilder) MaxAttempts(maxAttempts int32) *SpecBuilder {
+	b.spec.MaxAttempts = &maxAttempts
+	return b
+}
+
+// Timeout sets the timeout of each run.
+func (b *SpecBuilder) Timeout(timeout time.Duration) *SpecBuilder {
+	b.spec.Timeout = &metav1.Duration{Duration: timeout}
+	return b
+}
+
+// Schedule sets the cron schedule to re-run.
+func (b *SpecBuilder) Schedule(schedule string) *SpecBuilder {
+	b.spec.Schedule = schedule
+	return b
+}
+
+// DriftDetection runs a plan at the interval to detect drift, with autoApply the drift is re-applied.
+func (b *SpecBuilder) DriftDetection(interval time.Duration, autoApply bool) *SpecBuilder {
+	b.spec.DriftDetection = &tfv1alpha2.TerraformDriftDetection{
+		Interval:  metav1.Duration{Duration: interval},
+		AutoApply: autoApply,
+	}
+	return b
+}
+
+// DestroyOnDelete runs a destroy when the TerraformApply is deleted.
+func (b *SpecBuilder) DestroyOnDelete() *SpecBuilder {
+	b.spec.DestroyOnDelete = true
+	return b
+}
+
+// OutputsSecretOnly stores the outputs only in the outputs Secret and not in the status.
+func (b *SpecBuilder) OutputsSecretOnly() *SpecBuilder {
+	b.spec.OutputsSecretOnly = true
+	return b
+}
+
+// Build returns a copy of the spec.
+func (b *SpecBuilder) Build() *tfv1alpha2.TerraformSpec {
+	return b.spec.DeepCopy()
+}
+
+// NewTerraformPlan returns a TerraformPlan with the given spec.
+func NewTerraformPlan(namespace, name string, spec *tfv1alpha2.TerraformSpec) *tfv1alpha2.TerraformPlan {
+	return &tfv1alpha2.TerraformPlan{
+		TypeMeta:   makeTypeMeta(KindPlan),
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       spec,
+	}
+}
+
+// NewTerraformApply returns a TerraformApply with the given spec.
+func NewTerraformApply(namespace, name string, spec *tfv1alpha2.TerraformSpec) *tfv1alpha2.TerraformApply {
+	return &tfv1alpha2.TerraformApply{
+		TypeMeta:   makeTypeMeta(KindApply),
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       spec,
+	}
+}
+
+// NewTerraformDestroy returns a TerraformDestroy with the given spec.
+func NewTerraformDestroy(namespace, name string, spec *tfv1alpha2.TerraformSpec) *tfv1alpha2.TerraformDestroy {
+	return &tfv1alpha2.TerraformDestroy{
+		TypeMeta:   makeTypeMeta(KindDestroy),
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       spec,
+	}
+}
+
+// NewSpecFrom returns a specFrom copying the spec of the named resource of the given kind.
+func NewSpecFrom(kind Kind, name string, waitForReady bool) *tfv1alpha2.TerraformSpecFrom {
+	specFrom := &tfv1alpha2.TerraformSpecFrom{WaitForReady: waitForReady}
+	switch kind {
+	case KindPlan:
+		specFrom.TFPlan = name
+	case KindApply:
+		specFrom.TFApply = name
+	case KindDestroy:
+		specFrom.TFDestroy = name
+	}
+	return specFrom
+}
+
+func makeTypeMeta(kind Kind) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		APIVersion: tfv1alpha2.SchemeGroupVersion.String(),
+		Kind:       string(kind),
+	}
+}
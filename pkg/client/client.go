@@ -0,0 +1,309 @@
+// Package client creates and watches Terraform resources using the v1alpha2 API.
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sort"
+	"time"
+
+	tfv1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	"github.com/danisla/terraform-operator/pkg/client/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Kind is the kind of a Terraform resource.
+type Kind string
+
+// Terraform resource kinds.
+const (
+	KindPlan    Kind = "TerraformPlan"
+	KindApply   Kind = "TerraformApply"
+	KindDestroy Kind = "TerraformDestroy"
+)
+
+// TerraformContainerName is the name of the container running terraform in the run pods.
+const TerraformContainerName = "terraform"
+
+// DefaultPollInterval is the default interval between status checks in WaitForCondition.
+const DefaultPollInterval = 5 * time.Second
+
+// Client creates and watches Terraform resources in a namespace.
+type Client struct {
+	Clientset  versioned.Interface
+	KubeClient kubernetes.Interface
+	Namespace  string
+
+	// PollInterval is the interval between status checks in WaitForCondition.
+	PollInterval time.Duration
+}
+
+// New returns a client for the namespace using the given clientsets.
+func New(clientset versioned.Interface, kubeClient kubernetes.Interface, namespace string) *Client {
+	return &Client{
+		Clientset:    clientset,
+		KubeClient:   kubeClient,
+		Namespace:    namespace,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// NewForConfig returns a client for the namespace using the given rest config.
+func NewForConfig(config *rest.Config, namespace string) (*Client, error) {
+	clientset, err := versioned.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return New(clientset, kubeClient, namespace), nil
+}
+
+// Create creates a TerraformPlan, TerraformApply or TerraformDestroy, the namespace of the client is used if not set.
+func (c *Client) Create(obj runtime.Object) (runtime.Object, error) {
+	switch tf := obj.(type) {
+	case *tfv1alpha2.TerraformPlan:
+		return c.Clientset.CtlV1alpha2().TerraformPlans(c.getNamespace(tf)).Create(tf)
+	case *tfv1alpha2.TerraformApply:
+		return c.Clientset.CtlV1alpha2().TerraformApplies(c.getNamespace(tf)).Create(tf)
+	case *tfv1alpha2.TerraformDestroy:
+		return c.Clientset.CtlV1alpha2().TerraformDestroys(c.getNamespace(tf)).Create(tf)
+	}
+	return nil, fmt.Errorf("Unsupported type: %T", obj)
+}
+
+// Get returns the named resource of the given kind.
+func (c *Client) Get(kind Kind, name string) (runtime.Object, error) {
+	switch kind {
+	case KindPlan:
+		return c.Clientset.CtlV1alpha2().TerraformPlans(c.Namespace).Get(name, metav1.GetOptions{})
+	case KindApply:
+		return c.Clientset.CtlV1alpha2().TerraformApplies(c.Namespace).Get(name, metav1.GetOptions{})
+	case KindDestroy:
+		return c.Clientset.CtlV1alpha2().TerraformDestroys(c.Namespace).Get(name, metav1.GetOptions{})
+	}
+	return nil, fmt.Errorf("Unsupported kind: %s", kind)
+}
+
+// Delete deletes the named resource of the given kind.
+func (c *Client) Delete(kind Kind, name string) error {
+	switch kind {
+	case KindPlan:
+		return c.Clientset.CtlV1alpha2().TerraformPlans(c.Namespace).Delete(name, &metav1.DeleteOptions{})
+	case KindApply:
+		return c.Clientset.CtlV1alpha2().TerraformApplies(c.Namespace).Delete(name, &metav1.DeleteOptions{})
+	case KindDestroy:
+		return c.Clientset.CtlV1alpha2().TerraformDestroys(c.Namespace).Delete(name, &metav1.DeleteOptions{})
+	}
+	return fmt.Errorf("Unsupported kind: %s", kind)
+}
+
+// WaitForCondition waits until the condition of the named resource is True and returns the resource.
+// The status must be observed for the current generation of the resource.
+// An error is returned if the run fails with no retry pending, after all attempts, or the context is done.
+// Transient errors getting the resource, like timeouts, throttling and connection errors, are retried on the next poll.
+func (c *Client) WaitForCondition(ctx context.Context, kind Kind, name string, conditionType tfv1alpha2.ConditionType) (runtime.Object, error) {
+	interval := c.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	var obj runtime.Object
+	err := wait.PollImmediateUntil(interval, func() (bool, error) {
+		var err error
+		obj, err = c.Get(kind, name)
+		if err != nil {
+			if isRetryableError(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		meta, status, err := getStatus(obj)
+		if err != nil {
+			return false, err
+		}
+
+		if status.ObservedGeneration < meta.GetGeneration() {
+			return false, nil
+		}
+
+		if condition := status.GetCondition(conditionType); condition != nil && condition.Status == tfv1alpha2.ConditionTrue {
+			return true, nil
+		}
+
+		// A failed attempt is only terminal when no retry is scheduled.
+		if status.PodStatus == tfv1alpha2.PodStatusFailed && status.RetryNextAt == nil {
+			return false, fmt.Errorf("%s/%s: Pod/%s: %s", kind, name, status.PodName, status.PodStatus)
+		}
+
+		return false, nil
+	}, ctx.Done())
+
+	if err == wait.ErrWaitTimeout {
+		return obj, fmt.Errorf("%s/%s: Timed out waiting for condition %s: %v", kind, name, conditionType, ctx.Err())
+	}
+
+	return obj, err
+}
+
+// isRetryableError returns true if the error is expected to go away on the next request.
+func isRetryableError(err error) bool {
+	switch {
+	case apierrors.IsServerTimeout(err), apierrors.IsTimeout(err), apierrors.IsTooManyRequests(err), apierrors.IsServiceUnavailable(err):
+		return true
+	case utilnet.IsConnectionReset(err), utilnet.IsProbableEOF(err):
+		return true
+	}
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	if _, ok := err.(*net.OpError); ok {
+		// Failed to connect to the API server, for example the connection was refused.
+		return true
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// GetOutputs returns the output vars of the named TerraformApply.
+// The values are read from the outputs Secret, which also has the sensitive outputs, or from the status if there is no Secret.
+func (c *Client) GetOutputs(name string) (map[string]string, error) {
+	tf, err := c.Clientset.CtlV1alpha2().TerraformApplies(c.Namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(map[string]string, 0)
+
+	if tf.Status.OutputsSecret != "" {
+		secret, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Get(tf.Status.OutputsSecret, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range secret.Data {
+			outputs[k] = string(v)
+		}
+		return outputs, nil
+	}
+
+	for _, v := range tf.Status.Outputs {
+		outputs[v.Name] = v.Value
+	}
+
+	return outputs, nil
+}
+
+// StreamLogs writes the terraform container logs of the current run of the named resource to w.
+// The logs are followed until the container exits or the context is done.
+func (c *Client) StreamLogs(ctx context.Context, kind Kind, name string, w io.Writer) error {
+	obj, err := c.Get(kind, name)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := getStatus(obj)
+	if err != nil {
+		return err
+	}
+
+	podName := status.PodName
+	if status.Job != nil {
+		if podName, err = c.getLatestJobPod(status.Job.Name); err != nil {
+			return err
+		}
+	}
+
+	if podName == "" {
+		return fmt.Errorf("%s/%s: No pod found for the current run", kind, name)
+	}
+
+	stream, err := c.KubeClient.CoreV1().Pods(c.Namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container: TerraformContainerName,
+		Follow:    true,
+	}).Stream()
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	// Close the stream to unblock the copy when the context is done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			stream.Close()
+		case <-done:
+		}
+	}()
+
+	if _, err := io.Copy(w, stream); err != nil && ctx.Err() == nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// getLatestJobPod returns the name of the most recently created pod of the Job.
+func (c *Client) getLatestJobPod(jobName string) (string, error) {
+	pods, err := c.KubeClient.CoreV1().Pods(c.Namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s", jobName),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var podName string
+	var created metav1.Time
+	for _, pod := range pods.Items {
+		if podName == "" || created.Before(&pod.CreationTimestamp) {
+			podName = pod.GetName()
+			created = pod.CreationTimestamp
+		}
+	}
+	return podName, nil
+}
+
+func (c *Client) getNamespace(obj metav1.Object) string {
+	if obj.GetNamespace() != "" {
+		return obj.GetNamespace()
+	}
+	return c.Namespace
+}
+
+// getStatus returns the object metadata and status of a TerraformPlan, TerraformApply or TerraformDestroy.
+func getStatus(obj runtime.Object) (metav1.Object, *tfv1alpha2.TerraformStatus, error) {
+	switch tf := obj.(type) {
+	case *tfv1alpha2.TerraformPlan:
+		return tf, &tf.Status, nil
+	case *tfv1alpha2.TerraformApply:
+		return tf, &tf.Status, nil
+	case *tfv1alpha2.TerraformDestroy:
+		return tf, &tf.Status, nil
+	}
+	return nil, nil, fmt.Errorf("Unsupported type: %T", obj)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
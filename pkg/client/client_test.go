@@ -0,0 +1,233 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"reflect"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	tfv1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	"github.com/danisla/terraform-operator/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestSpecBuilder(t *testing.T) {
+	spec := NewSpec().
+		ProviderConfig("google", "tf-provider-google").
+		ConfigMapSource("tf-src", true).
+		TFVars(map[string]string{"zone": "us-central1-f", "region": "us-central1"}).
+		TFInput("tf-base", true, map[string]string{"network": "network_name"}).
+		Timeout(10 * time.Minute).
+		Build()
+
+	expected := &tfv1alpha2.TerraformSpec{
+		ProviderConfig: []tfv1alpha2.TerraformSpecProviderConfig{{Name: "google", SecretName: "tf-provider-google"}},
+		Sources:        []tfv1alpha2.TerraformConfigSource{{ConfigMap: &tfv1alpha2.TerraformSourceConfigMap{Name: "tf-src", Trigger: true}}},
+		TFVars:         []tfv1alpha2.TFVar{{Name: "region", Value: "us-central1"}, {Name: "zone", Value: "us-central1-f"}},
+		TFInputs: []tfv1alpha2.TerraformConfigInputs{
+			{Name: "tf-base", WaitForReady: true, VarMap: []tfv1alpha2.VarMapItem{{Source: "network", Dest: "network_name"}}},
+		},
+		Timeout: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+
+	if !reflect.DeepEqual(expected, spec) {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", expected, spec)
+	}
+}
+
+func TestWaitForCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    tfv1alpha2.TerraformStatus
+		expectErr string
+	}{
+		{
+			"ready",
+			tfv1alpha2.TerraformStatus{
+				PodStatus:  tfv1alpha2.PodStatusPassed,
+				Conditions: []tfv1alpha2.Condition{{Type: tfv1alpha2.ConditionReady, Status: tfv1alpha2.ConditionTrue}},
+			},
+			"",
+		},
+		{
+			"failed",
+			tfv1alpha2.TerraformStatus{
+				PodName:    "test-2",
+				PodStatus:  tfv1alpha2.PodStatusFailed,
+				Conditions: []tfv1alpha2.Condition{{Type: tfv1alpha2.ConditionReady, Status: tfv1alpha2.ConditionFalse}},
+			},
+			"Pod/test-2: FAILED",
+		},
+		{
+			"failed with retry pending",
+			tfv1alpha2.TerraformStatus{
+				PodName:     "test-0",
+				PodStatus:   tfv1alpha2.PodStatusFailed,
+				RetryNextAt: &metav1.Time{Time: time.Now().Add(time.Minute)},
+				Conditions:  []tfv1alpha2.Condition{{Type: tfv1alpha2.ConditionReady, Status: tfv1alpha2.ConditionFalse}},
+			},
+			"Timed out",
+		},
+		{
+			"timeout",
+			tfv1alpha2.TerraformStatus{
+				PodStatus:  tfv1alpha2.PodStatusRunning,
+				Conditions: []tfv1alpha2.Condition{{Type: tfv1alpha2.ConditionReady, Status: tfv1alpha2.ConditionFalse}},
+			},
+			"Timed out",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tf := NewTerraformApply("default", "test", NewSpec().Build())
+			tf.Status = tc.status
+
+			c := New(fake.NewSimpleClientset(), kubefake.NewSimpleClientset(), "default")
+			c.PollInterval = 10 * time.Millisecond
+			if _, err := c.Create(tf); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			_, err := c.WaitForCondition(ctx, KindApply, "test", tfv1alpha2.ConditionReady)
+			if tc.expectErr == "" && err != nil || tc.expectErr != "" && (err == nil || !strings.Contains(err.Error(), tc.expectErr)) {
+				t.Errorf("\n\texp error: %v\n\n\tgot: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestWaitForConditionGetErrors(t *testing.T) {
+	resource := schema.GroupResource{Group: tfv1alpha2.SchemeGroupVersion.Group, Resource: "terraformapplies"}
+
+	tests := []struct {
+		name      string
+		getErr    error
+		expectErr string
+	}{
+		{"server timeout", apierrors.NewServerTimeout(resource, "get", 1), ""},
+		{"too many requests", apierrors.NewTooManyRequests("throttled", 1), ""},
+		{"service unavailable", apierrors.NewServiceUnavailable("unavailable"), ""},
+		{"connection refused", &url.Error{Op: "Get", URL: "https://10.0.0.1/apis", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}, ""},
+		{"not found", apierrors.NewNotFound(resource, "test"), "not found"},
+		{"forbidden", apierrors.NewForbidden(resource, "test", nil), "forbidden"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tf := NewTerraformApply("default", "test", NewSpec().Build())
+			tf.Status = tfv1alpha2.TerraformStatus{
+				PodStatus:  tfv1alpha2.PodStatusPassed,
+				Conditions: []tfv1alpha2.Condition{{Type: tfv1alpha2.ConditionReady, Status: tfv1alpha2.ConditionTrue}},
+			}
+
+			clientset := fake.NewSimpleClientset()
+			c := New(clientset, kubefake.NewSimpleClientset(), "default")
+			c.PollInterval = 10 * time.Millisecond
+			if _, err := c.Create(tf); err != nil {
+				t.Fatal(err)
+			}
+
+			// The first get fails, the next ones are served by the object tracker.
+			failed := false
+			clientset.PrependReactor("get", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if failed {
+					return false, nil, nil
+				}
+				failed = true
+				return true, nil, tc.getErr
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			_, err := c.WaitForCondition(ctx, KindApply, "test", tfv1alpha2.ConditionReady)
+			if !failed {
+				t.Fatal("expected the get error")
+			}
+			if tc.expectErr == "" && err != nil || tc.expectErr != "" && (err == nil || !strings.Contains(err.Error(), tc.expectErr)) {
+				t.Errorf("\n\texp error: %v\n\n\tgot: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestGetOutputs(t *testing.T) {
+	withSecret := NewTerraformApply("default", "with-secret", NewSpec().Build())
+	withSecret.Status.Outputs = []tfv1alpha2.TerraformOutputVar{{Name: "ip", Value: "10.0.0.1"}}
+	withSecret.Status.OutputsSecret = "with-secret-tfapply-outputs"
+
+	noSecret := NewTerraformApply("default", "no-secret", NewSpec().Build())
+	noSecret.Status.Outputs = []tfv1alpha2.TerraformOutputVar{{Name: "ip", Value: "10.0.0.2"}}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "with-secret-tfapply-outputs",
+			Annotations: map[string]string{tfv1alpha2.AnnotationOutputsHashSalt: "abc123"},
+		},
+		Data: map[string][]byte{
+			"ip":       []byte("10.0.0.1"),
+			"password": []byte("hunter2"),
+		},
+	}
+
+	// The fake object tracker guesses the terraformapplies resource from the kind, create through the client instead.
+	c := New(fake.NewSimpleClientset(), kubefake.NewSimpleClientset(secret), "default")
+	for _, tf := range []*tfv1alpha2.TerraformApply{withSecret, noSecret} {
+		if _, err := c.Create(tf); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		expected map[string]string
+	}{
+		{"with-secret", map[string]string{"ip": "10.0.0.1", "password": "hunter2"}},
+		{"no-secret", map[string]string{"ip": "10.0.0.2"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := c.GetOutputs(tc.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.expected, got) {
+				t.Errorf("\n\texp: %#v\n\n\tgot: %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	c := New(fake.NewSimpleClientset(), kubefake.NewSimpleClientset(), "default")
+
+	spec := NewSpec().ProviderConfig("google", "tf-provider-google").EmbeddedSource("variable \"region\" {}").Build()
+	if _, err := c.Create(NewTerraformPlan("", "test", spec)); err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := c.Get(KindPlan, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := obj.(*tfv1alpha2.TerraformPlan).Spec; !reflect.DeepEqual(spec, got) {
+		t.Errorf("\n\texp: %#v\n\n\tgot: %#v", spec, got)
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	"github.com/danisla/terraform-operator/pkg/client/clientset/versioned/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+type CtlV1alpha2Interface interface {
+	RESTClient() rest.Interface
+	TerraformAppliesGetter
+	TerraformDestroysGetter
+	TerraformPlansGetter
+}
+
+// CtlV1alpha2Client is used to interact with features provided by the ctl.isla.solutions group.
+type CtlV1alpha2Client struct {
+	restClient rest.Interface
+}
+
+func (c *CtlV1alpha2Client) TerraformApplies(namespace string) TerraformApplyInterface {
+	return newTerraformApplies(c, namespace)
+}
+
+func (c *CtlV1alpha2Client) TerraformDestroys(namespace string) TerraformDestroyInterface {
+	return newTerraformDestroys(c, namespace)
+}
+
+func (c *CtlV1alpha2Client) TerraformPlans(namespace string) TerraformPlanInterface {
+	return newTerraformPlans(c, namespace)
+}
+
+// NewForConfig creates a new CtlV1alpha2Client for the given config.
+func NewForConfig(c *rest.Config) (*CtlV1alpha2Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &CtlV1alpha2Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new CtlV1alpha2Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *CtlV1alpha2Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new CtlV1alpha2Client for the given RESTClient.
+func New(c rest.Interface) *CtlV1alpha2Client {
+	return &CtlV1alpha2Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1alpha2.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *CtlV1alpha2Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
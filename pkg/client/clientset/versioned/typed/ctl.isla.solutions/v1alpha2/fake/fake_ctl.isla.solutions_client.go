@@ -0,0 +1,32 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/client/clientset/versioned/typed/ctl.isla.solutions/v1alpha2"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeCtlV1alpha2 struct {
+	*testing.Fake
+}
+
+func (c *FakeCtlV1alpha2) TerraformApplies(namespace string) v1alpha2.TerraformApplyInterface {
+	return &FakeTerraformApplies{c, namespace}
+}
+
+func (c *FakeCtlV1alpha2) TerraformDestroys(namespace string) v1alpha2.TerraformDestroyInterface {
+	return &FakeTerraformDestroys{c, namespace}
+}
+
+func (c *FakeCtlV1alpha2) TerraformPlans(namespace string) v1alpha2.TerraformPlanInterface {
+	return &FakeTerraformPlans{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeCtlV1alpha2) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
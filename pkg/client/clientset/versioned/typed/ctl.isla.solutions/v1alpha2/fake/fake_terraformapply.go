@@ -0,0 +1,124 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTerraformApplies implements TerraformApplyInterface
+type FakeTerraformApplies struct {
+	Fake *FakeCtlV1alpha2
+	ns   string
+}
+
+var terraformappliesResource = schema.GroupVersionResource{Group: "ctl.isla.solutions", Version: "v1alpha2", Resource: "terraformapplys"}
+
+var terraformappliesKind = schema.GroupVersionKind{Group: "ctl.isla.solutions", Version: "v1alpha2", Kind: "TerraformApply"}
+
+// Get takes name of the terraformApply, and returns the corresponding terraformApply object, and an error if there is any.
+func (c *FakeTerraformApplies) Get(name string, options v1.GetOptions) (result *v1alpha2.TerraformApply, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(terraformappliesResource, c.ns, name), &v1alpha2.TerraformApply{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformApply), err
+}
+
+// List takes label and field selectors, and returns the list of TerraformApplies that match those selectors.
+func (c *FakeTerraformApplies) List(opts v1.ListOptions) (result *v1alpha2.TerraformApplyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(terraformappliesResource, terraformappliesKind, c.ns, opts), &v1alpha2.TerraformApplyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.TerraformApplyList{ListMeta: obj.(*v1alpha2.TerraformApplyList).ListMeta}
+	for _, item := range obj.(*v1alpha2.TerraformApplyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested terraformApplies.
+func (c *FakeTerraformApplies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(terraformappliesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a terraformApply and creates it.  Returns the server's representation of the terraformApply, and an error, if there is any.
+func (c *FakeTerraformApplies) Create(terraformApply *v1alpha2.TerraformApply) (result *v1alpha2.TerraformApply, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(terraformappliesResource, c.ns, terraformApply), &v1alpha2.TerraformApply{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformApply), err
+}
+
+// Update takes the representation of a terraformApply and updates it. Returns the server's representation of the terraformApply, and an error, if there is any.
+func (c *FakeTerraformApplies) Update(terraformApply *v1alpha2.TerraformApply) (result *v1alpha2.TerraformApply, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(terraformappliesResource, c.ns, terraformApply), &v1alpha2.TerraformApply{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformApply), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTerraformApplies) UpdateStatus(terraformApply *v1alpha2.TerraformApply) (*v1alpha2.TerraformApply, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(terraformappliesResource, "status", c.ns, terraformApply), &v1alpha2.TerraformApply{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformApply), err
+}
+
+// Delete takes name of the terraformApply and deletes it. Returns an error if one occurs.
+func (c *FakeTerraformApplies) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(terraformappliesResource, c.ns, name), &v1alpha2.TerraformApply{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTerraformApplies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(terraformappliesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.TerraformApplyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched terraformApply.
+func (c *FakeTerraformApplies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformApply, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(terraformappliesResource, c.ns, name, pt, data, subresources...), &v1alpha2.TerraformApply{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformApply), err
+}
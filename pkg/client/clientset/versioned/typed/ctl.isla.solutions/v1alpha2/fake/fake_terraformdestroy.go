@@ -0,0 +1,124 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTerraformDestroys implements TerraformDestroyInterface
+type FakeTerraformDestroys struct {
+	Fake *FakeCtlV1alpha2
+	ns   string
+}
+
+var terraformdestroysResource = schema.GroupVersionResource{Group: "ctl.isla.solutions", Version: "v1alpha2", Resource: "terraformdestroys"}
+
+var terraformdestroysKind = schema.GroupVersionKind{Group: "ctl.isla.solutions", Version: "v1alpha2", Kind: "TerraformDestroy"}
+
+// Get takes name of the terraformDestroy, and returns the corresponding terraformDestroy object, and an error if there is any.
+func (c *FakeTerraformDestroys) Get(name string, options v1.GetOptions) (result *v1alpha2.TerraformDestroy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(terraformdestroysResource, c.ns, name), &v1alpha2.TerraformDestroy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformDestroy), err
+}
+
+// List takes label and field selectors, and returns the list of TerraformDestroys that match those selectors.
+func (c *FakeTerraformDestroys) List(opts v1.ListOptions) (result *v1alpha2.TerraformDestroyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(terraformdestroysResource, terraformdestroysKind, c.ns, opts), &v1alpha2.TerraformDestroyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.TerraformDestroyList{ListMeta: obj.(*v1alpha2.TerraformDestroyList).ListMeta}
+	for _, item := range obj.(*v1alpha2.TerraformDestroyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested terraformDestroys.
+func (c *FakeTerraformDestroys) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(terraformdestroysResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a terraformDestroy and creates it.  Returns the server's representation of the terraformDestroy, and an error, if there is any.
+func (c *FakeTerraformDestroys) Create(terraformDestroy *v1alpha2.TerraformDestroy) (result *v1alpha2.TerraformDestroy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(terraformdestroysResource, c.ns, terraformDestroy), &v1alpha2.TerraformDestroy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformDestroy), err
+}
+
+// Update takes the representation of a terraformDestroy and updates it. Returns the server's representation of the terraformDestroy, and an error, if there is any.
+func (c *FakeTerraformDestroys) Update(terraformDestroy *v1alpha2.TerraformDestroy) (result *v1alpha2.TerraformDestroy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(terraformdestroysResource, c.ns, terraformDestroy), &v1alpha2.TerraformDestroy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformDestroy), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTerraformDestroys) UpdateStatus(terraformDestroy *v1alpha2.TerraformDestroy) (*v1alpha2.TerraformDestroy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(terraformdestroysResource, "status", c.ns, terraformDestroy), &v1alpha2.TerraformDestroy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformDestroy), err
+}
+
+// Delete takes name of the terraformDestroy and deletes it. Returns an error if one occurs.
+func (c *FakeTerraformDestroys) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(terraformdestroysResource, c.ns, name), &v1alpha2.TerraformDestroy{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTerraformDestroys) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(terraformdestroysResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.TerraformDestroyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched terraformDestroy.
+func (c *FakeTerraformDestroys) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformDestroy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(terraformdestroysResource, c.ns, name, pt, data, subresources...), &v1alpha2.TerraformDestroy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformDestroy), err
+}
@@ -0,0 +1,124 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTerraformPlans implements TerraformPlanInterface
+type FakeTerraformPlans struct {
+	Fake *FakeCtlV1alpha2
+	ns   string
+}
+
+var terraformplansResource = schema.GroupVersionResource{Group: "ctl.isla.solutions", Version: "v1alpha2", Resource: "terraformplans"}
+
+var terraformplansKind = schema.GroupVersionKind{Group: "ctl.isla.solutions", Version: "v1alpha2", Kind: "TerraformPlan"}
+
+// Get takes name of the terraformPlan, and returns the corresponding terraformPlan object, and an error if there is any.
+func (c *FakeTerraformPlans) Get(name string, options v1.GetOptions) (result *v1alpha2.TerraformPlan, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(terraformplansResource, c.ns, name), &v1alpha2.TerraformPlan{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformPlan), err
+}
+
+// List takes label and field selectors, and returns the list of TerraformPlans that match those selectors.
+func (c *FakeTerraformPlans) List(opts v1.ListOptions) (result *v1alpha2.TerraformPlanList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(terraformplansResource, terraformplansKind, c.ns, opts), &v1alpha2.TerraformPlanList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.TerraformPlanList{ListMeta: obj.(*v1alpha2.TerraformPlanList).ListMeta}
+	for _, item := range obj.(*v1alpha2.TerraformPlanList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested terraformPlans.
+func (c *FakeTerraformPlans) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(terraformplansResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a terraformPlan and creates it.  Returns the server's representation of the terraformPlan, and an error, if there is any.
+func (c *FakeTerraformPlans) Create(terraformPlan *v1alpha2.TerraformPlan) (result *v1alpha2.TerraformPlan, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(terraformplansResource, c.ns, terraformPlan), &v1alpha2.TerraformPlan{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformPlan), err
+}
+
+// Update takes the representation of a terraformPlan and updates it. Returns the server's representation of the terraformPlan, and an error, if there is any.
+func (c *FakeTerraformPlans) Update(terraformPlan *v1alpha2.TerraformPlan) (result *v1alpha2.TerraformPlan, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(terraformplansResource, c.ns, terraformPlan), &v1alpha2.TerraformPlan{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformPlan), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTerraformPlans) UpdateStatus(terraformPlan *v1alpha2.TerraformPlan) (*v1alpha2.TerraformPlan, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(terraformplansResource, "status", c.ns, terraformPlan), &v1alpha2.TerraformPlan{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformPlan), err
+}
+
+// Delete takes name of the terraformPlan and deletes it. Returns an error if one occurs.
+func (c *FakeTerraformPlans) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(terraformplansResource, c.ns, name), &v1alpha2.TerraformPlan{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTerraformPlans) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(terraformplansResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.TerraformPlanList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched terraformPlan.
+func (c *FakeTerraformPlans) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformPlan, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(terraformplansResource, c.ns, name, pt, data, subresources...), &v1alpha2.TerraformPlan{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TerraformPlan), err
+}
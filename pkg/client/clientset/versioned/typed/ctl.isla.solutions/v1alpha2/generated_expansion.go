@@ -0,0 +1,9 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+type TerraformApplyExpansion interface{}
+
+type TerraformDestroyExpansion interface{}
+
+type TerraformPlanExpansion interface{}
@@ -0,0 +1,175 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	scheme "github.com/danisla/terraform-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TerraformAppliesGetter has a method to return a TerraformApplyInterface.
+// A group's client should implement this interface.
+type TerraformAppliesGetter interface {
+	TerraformApplies(namespace string) TerraformApplyInterface
+}
+
+// TerraformApplyInterface has methods to work with TerraformApply resources.
+type TerraformApplyInterface interface {
+	Create(*v1alpha2.TerraformApply) (*v1alpha2.TerraformApply, error)
+	Update(*v1alpha2.TerraformApply) (*v1alpha2.TerraformApply, error)
+	UpdateStatus(*v1alpha2.TerraformApply) (*v1alpha2.TerraformApply, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.TerraformApply, error)
+	List(opts v1.ListOptions) (*v1alpha2.TerraformApplyList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformApply, err error)
+	TerraformApplyExpansion
+}
+
+// terraformApplies implements TerraformApplyInterface
+type terraformApplies struct {
+	client rest.Interface
+	ns     string
+}
+
+// newTerraformApplies returns a TerraformApplies
+func newTerraformApplies(c *CtlV1alpha2Client, namespace string) *terraformApplies {
+	return &terraformApplies{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the terraformApply, and returns the corresponding terraformApply object, and an error if there is any.
+func (c *terraformApplies) Get(name string, options v1.GetOptions) (result *v1alpha2.TerraformApply, err error) {
+	result = &v1alpha2.TerraformApply{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TerraformApplies that match those selectors.
+func (c *terraformApplies) List(opts v1.ListOptions) (result *v1alpha2.TerraformApplyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.TerraformApplyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested terraformApplies.
+func (c *terraformApplies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a terraformApply and creates it.  Returns the server's representation of the terraformApply, and an error, if there is any.
+func (c *terraformApplies) Create(terraformApply *v1alpha2.TerraformApply) (result *v1alpha2.TerraformApply, err error) {
+	result = &v1alpha2.TerraformApply{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		Body(terraformApply).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a terraformApply and updates it. Returns the server's representation of the terraformApply, and an error, if there is any.
+func (c *terraformApplies) Update(terraformApply *v1alpha2.TerraformApply) (result *v1alpha2.TerraformApply, err error) {
+	result = &v1alpha2.TerraformApply{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		Name(terraformApply.Name).
+		Body(terraformApply).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *terraformApplies) UpdateStatus(terraformApply *v1alpha2.TerraformApply) (result *v1alpha2.TerraformApply, err error) {
+	result = &v1alpha2.TerraformApply{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		Name(terraformApply.Name).
+		SubResource("status").
+		Body(terraformApply).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the terraformApply and deletes it. Returns an error if one occurs.
+func (c *terraformApplies) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *terraformApplies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched terraformApply.
+func (c *terraformApplies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformApply, err error) {
+	result = &v1alpha2.TerraformApply{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("terraformapplys").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
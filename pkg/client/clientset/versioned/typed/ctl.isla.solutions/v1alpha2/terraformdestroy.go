@@ -0,0 +1,175 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	scheme "github.com/danisla/terraform-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TerraformDestroysGetter has a method to return a TerraformDestroyInterface.
+// A group's client should implement this interface.
+type TerraformDestroysGetter interface {
+	TerraformDestroys(namespace string) TerraformDestroyInterface
+}
+
+// TerraformDestroyInterface has methods to work with TerraformDestroy resources.
+type TerraformDestroyInterface interface {
+	Create(*v1alpha2.TerraformDestroy) (*v1alpha2.TerraformDestroy, error)
+	Update(*v1alpha2.TerraformDestroy) (*v1alpha2.TerraformDestroy, error)
+	UpdateStatus(*v1alpha2.TerraformDestroy) (*v1alpha2.TerraformDestroy, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.TerraformDestroy, error)
+	List(opts v1.ListOptions) (*v1alpha2.TerraformDestroyList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformDestroy, err error)
+	TerraformDestroyExpansion
+}
+
+// terraformDestroys implements TerraformDestroyInterface
+type terraformDestroys struct {
+	client rest.Interface
+	ns     string
+}
+
+// newTerraformDestroys returns a TerraformDestroys
+func newTerraformDestroys(c *CtlV1alpha2Client, namespace string) *terraformDestroys {
+	return &terraformDestroys{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the terraformDestroy, and returns the corresponding terraformDestroy object, and an error if there is any.
+func (c *terraformDestroys) Get(name string, options v1.GetOptions) (result *v1alpha2.TerraformDestroy, err error) {
+	result = &v1alpha2.TerraformDestroy{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TerraformDestroys that match those selectors.
+func (c *terraformDestroys) List(opts v1.ListOptions) (result *v1alpha2.TerraformDestroyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.TerraformDestroyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested terraformDestroys.
+func (c *terraformDestroys) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a terraformDestroy and creates it.  Returns the server's representation of the terraformDestroy, and an error, if there is any.
+func (c *terraformDestroys) Create(terraformDestroy *v1alpha2.TerraformDestroy) (result *v1alpha2.TerraformDestroy, err error) {
+	result = &v1alpha2.TerraformDestroy{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		Body(terraformDestroy).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a terraformDestroy and updates it. Returns the server's representation of the terraformDestroy, and an error, if there is any.
+func (c *terraformDestroys) Update(terraformDestroy *v1alpha2.TerraformDestroy) (result *v1alpha2.TerraformDestroy, err error) {
+	result = &v1alpha2.TerraformDestroy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		Name(terraformDestroy.Name).
+		Body(terraformDestroy).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *terraformDestroys) UpdateStatus(terraformDestroy *v1alpha2.TerraformDestroy) (result *v1alpha2.TerraformDestroy, err error) {
+	result = &v1alpha2.TerraformDestroy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		Name(terraformDestroy.Name).
+		SubResource("status").
+		Body(terraformDestroy).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the terraformDestroy and deletes it. Returns an error if one occurs.
+func (c *terraformDestroys) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *terraformDestroys) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched terraformDestroy.
+func (c *terraformDestroys) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformDestroy, err error) {
+	result = &v1alpha2.TerraformDestroy{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("terraformdestroys").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
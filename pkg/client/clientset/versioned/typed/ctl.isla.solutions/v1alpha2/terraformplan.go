@@ -0,0 +1,175 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	scheme "github.com/danisla/terraform-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TerraformPlansGetter has a method to return a TerraformPlanInterface.
+// A group's client should implement this interface.
+type TerraformPlansGetter interface {
+	TerraformPlans(namespace string) TerraformPlanInterface
+}
+
+// TerraformPlanInterface has methods to work with TerraformPlan resources.
+type TerraformPlanInterface interface {
+	Create(*v1alpha2.TerraformPlan) (*v1alpha2.TerraformPlan, error)
+	Update(*v1alpha2.TerraformPlan) (*v1alpha2.TerraformPlan, error)
+	UpdateStatus(*v1alpha2.TerraformPlan) (*v1alpha2.TerraformPlan, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.TerraformPlan, error)
+	List(opts v1.ListOptions) (*v1alpha2.TerraformPlanList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformPlan, err error)
+	TerraformPlanExpansion
+}
+
+// terraformPlans implements TerraformPlanInterface
+type terraformPlans struct {
+	client rest.Interface
+	ns     string
+}
+
+// newTerraformPlans returns a TerraformPlans
+func newTerraformPlans(c *CtlV1alpha2Client, namespace string) *terraformPlans {
+	return &terraformPlans{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the terraformPlan, and returns the corresponding terraformPlan object, and an error if there is any.
+func (c *terraformPlans) Get(name string, options v1.GetOptions) (result *v1alpha2.TerraformPlan, err error) {
+	result = &v1alpha2.TerraformPlan{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TerraformPlans that match those selectors.
+func (c *terraformPlans) List(opts v1.ListOptions) (result *v1alpha2.TerraformPlanList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.TerraformPlanList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested terraformPlans.
+func (c *terraformPlans) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a terraformPlan and creates it.  Returns the server's representation of the terraformPlan, and an error, if there is any.
+func (c *terraformPlans) Create(terraformPlan *v1alpha2.TerraformPlan) (result *v1alpha2.TerraformPlan, err error) {
+	result = &v1alpha2.TerraformPlan{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		Body(terraformPlan).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a terraformPlan and updates it. Returns the server's representation of the terraformPlan, and an error, if there is any.
+func (c *terraformPlans) Update(terraformPlan *v1alpha2.TerraformPlan) (result *v1alpha2.TerraformPlan, err error) {
+	result = &v1alpha2.TerraformPlan{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		Name(terraformPlan.Name).
+		Body(terraformPlan).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *terraformPlans) UpdateStatus(terraformPlan *v1alpha2.TerraformPlan) (result *v1alpha2.TerraformPlan, err error) {
+	result = &v1alpha2.TerraformPlan{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		Name(terraformPlan.Name).
+		SubResource("status").
+		Body(terraformPlan).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the terraformPlan and deletes it. Returns an error if one occurs.
+func (c *terraformPlans) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *terraformPlans) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformplans").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched terraformPlan.
+func (c *terraformPlans) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.TerraformPlan, err error) {
+	result = &v1alpha2.TerraformPlan{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("terraformplans").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,43 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	internalinterfaces "github.com/danisla/terraform-operator/pkg/client/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// TerraformApplies returns a TerraformApplyInformer.
+	TerraformApplies() TerraformApplyInformer
+	// TerraformDestroys returns a TerraformDestroyInformer.
+	TerraformDestroys() TerraformDestroyInformer
+	// TerraformPlans returns a TerraformPlanInformer.
+	TerraformPlans() TerraformPlanInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// TerraformApplies returns a TerraformApplyInformer.
+func (v *version) TerraformApplies() TerraformApplyInformer {
+	return &terraformApplyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TerraformDestroys returns a TerraformDestroyInformer.
+func (v *version) TerraformDestroys() TerraformDestroyInformer {
+	return &terraformDestroyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TerraformPlans returns a TerraformPlanInformer.
+func (v *version) TerraformPlans() TerraformPlanInformer {
+	return &terraformPlanInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
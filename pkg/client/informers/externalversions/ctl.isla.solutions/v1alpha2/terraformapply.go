@@ -0,0 +1,73 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	time "time"
+
+	ctlislasolutionsv1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	versioned "github.com/danisla/terraform-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/danisla/terraform-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/client/listers/ctl.isla.solutions/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// TerraformApplyInformer provides access to a shared informer and lister for
+// TerraformApplies.
+type TerraformApplyInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha2.TerraformApplyLister
+}
+
+type terraformApplyInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewTerraformApplyInformer constructs a new informer for TerraformApply type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewTerraformApplyInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredTerraformApplyInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredTerraformApplyInformer constructs a new informer for TerraformApply type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredTerraformApplyInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CtlV1alpha2().TerraformApplies(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CtlV1alpha2().TerraformApplies(namespace).Watch(options)
+			},
+		},
+		&ctlislasolutionsv1alpha2.TerraformApply{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *terraformApplyInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredTerraformApplyInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *terraformApplyInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&ctlislasolutionsv1alpha2.TerraformApply{}, f.defaultInformer)
+}
+
+func (f *terraformApplyInformer) Lister() v1alpha2.TerraformApplyLister {
+	return v1alpha2.NewTerraformApplyLister(f.Informer().GetIndexer())
+}
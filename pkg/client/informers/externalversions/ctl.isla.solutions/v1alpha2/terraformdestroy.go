@@ -0,0 +1,73 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	time "time"
+
+	ctlislasolutionsv1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	versioned "github.com/danisla/terraform-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/danisla/terraform-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/client/listers/ctl.isla.solutions/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// TerraformDestroyInformer provides access to a shared informer and lister for
+// TerraformDestroys.
+type TerraformDestroyInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha2.TerraformDestroyLister
+}
+
+type terraformDestroyInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewTerraformDestroyInformer constructs a new informer for TerraformDestroy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewTerraformDestroyInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredTerraformDestroyInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredTerraformDestroyInformer constructs a new informer for TerraformDestroy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredTerraformDestroyInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CtlV1alpha2().TerraformDestroys(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CtlV1alpha2().TerraformDestroys(namespace).Watch(options)
+			},
+		},
+		&ctlislasolutionsv1alpha2.TerraformDestroy{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *terraformDestroyInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredTerraformDestroyInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *terraformDestroyInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&ctlislasolutionsv1alpha2.TerraformDestroy{}, f.defaultInformer)
+}
+
+func (f *terraformDestroyInformer) Lister() v1alpha2.TerraformDestroyLister {
+	return v1alpha2.NewTerraformDestroyLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,73 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	time "time"
+
+	ctlislasolutionsv1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	versioned "github.com/danisla/terraform-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/danisla/terraform-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/client/listers/ctl.isla.solutions/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// TerraformPlanInformer provides access to a shared informer and lister for
+// TerraformPlans.
+type TerraformPlanInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha2.TerraformPlanLister
+}
+
+type terraformPlanInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewTerraformPlanInformer constructs a new informer for TerraformPlan type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewTerraformPlanInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredTerraformPlanInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredTerraformPlanInformer constructs a new informer for TerraformPlan type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredTerraformPlanInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CtlV1alpha2().TerraformPlans(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CtlV1alpha2().TerraformPlans(namespace).Watch(options)
+			},
+		},
+		&ctlislasolutionsv1alpha2.TerraformPlan{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *terraformPlanInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredTerraformPlanInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *terraformPlanInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&ctlislasolutionsv1alpha2.TerraformPlan{}, f.defaultInformer)
+}
+
+func (f *terraformPlanInformer) Lister() v1alpha2.TerraformPlanLister {
+	return v1alpha2.NewTerraformPlanLister(f.Informer().GetIndexer())
+}
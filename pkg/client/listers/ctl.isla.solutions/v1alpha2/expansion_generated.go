@@ -0,0 +1,27 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// TerraformApplyListerExpansion allows custom methods to be added to
+// TerraformApplyLister.
+type TerraformApplyListerExpansion interface{}
+
+// TerraformApplyNamespaceListerExpansion allows custom methods to be added to
+// TerraformApplyNamespaceLister.
+type TerraformApplyNamespaceListerExpansion interface{}
+
+// TerraformDestroyListerExpansion allows custom methods to be added to
+// TerraformDestroyLister.
+type TerraformDestroyListerExpansion interface{}
+
+// TerraformDestroyNamespaceListerExpansion allows custom methods to be added to
+// TerraformDestroyNamespaceLister.
+type TerraformDestroyNamespaceListerExpansion interface{}
+
+// TerraformPlanListerExpansion allows custom methods to be added to
+// TerraformPlanLister.
+type TerraformPlanListerExpansion interface{}
+
+// TerraformPlanNamespaceListerExpansion allows custom methods to be added to
+// TerraformPlanNamespaceLister.
+type TerraformPlanNamespaceListerExpansion interface{}
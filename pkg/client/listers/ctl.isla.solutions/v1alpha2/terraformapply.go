@@ -0,0 +1,78 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TerraformApplyLister helps list TerraformApplies.
+type TerraformApplyLister interface {
+	// List lists all TerraformApplies in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha2.TerraformApply, err error)
+	// TerraformApplies returns an object that can list and get TerraformApplies.
+	TerraformApplies(namespace string) TerraformApplyNamespaceLister
+	TerraformApplyListerExpansion
+}
+
+// terraformApplyLister implements the TerraformApplyLister interface.
+type terraformApplyLister struct {
+	indexer cache.Indexer
+}
+
+// NewTerraformApplyLister returns a new TerraformApplyLister.
+func NewTerraformApplyLister(indexer cache.Indexer) TerraformApplyLister {
+	return &terraformApplyLister{indexer: indexer}
+}
+
+// List lists all TerraformApplies in the indexer.
+func (s *terraformApplyLister) List(selector labels.Selector) (ret []*v1alpha2.TerraformApply, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.TerraformApply))
+	})
+	return ret, err
+}
+
+// TerraformApplies returns an object that can list and get TerraformApplies.
+func (s *terraformApplyLister) TerraformApplies(namespace string) TerraformApplyNamespaceLister {
+	return terraformApplyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TerraformApplyNamespaceLister helps list and get TerraformApplies.
+type TerraformApplyNamespaceLister interface {
+	// List lists all TerraformApplies in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha2.TerraformApply, err error)
+	// Get retrieves the TerraformApply from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha2.TerraformApply, error)
+	TerraformApplyNamespaceListerExpansion
+}
+
+// terraformApplyNamespaceLister implements the TerraformApplyNamespaceLister
+// interface.
+type terraformApplyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TerraformApplies in the indexer for a given namespace.
+func (s terraformApplyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha2.TerraformApply, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.TerraformApply))
+	})
+	return ret, err
+}
+
+// Get retrieves the TerraformApply from the indexer for a given namespace and name.
+func (s terraformApplyNamespaceLister) Get(name string) (*v1alpha2.TerraformApply, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha2.Resource("terraformapply"), name)
+	}
+	return obj.(*v1alpha2.TerraformApply), nil
+}
@@ -0,0 +1,78 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TerraformDestroyLister helps list TerraformDestroys.
+type TerraformDestroyLister interface {
+	// List lists all TerraformDestroys in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha2.TerraformDestroy, err error)
+	// TerraformDestroys returns an object that can list and get TerraformDestroys.
+	TerraformDestroys(namespace string) TerraformDestroyNamespaceLister
+	TerraformDestroyListerExpansion
+}
+
+// terraformDestroyLister implements the TerraformDestroyLister interface.
+type terraformDestroyLister struct {
+	indexer cache.Indexer
+}
+
+// NewTerraformDestroyLister returns a new TerraformDestroyLister.
+func NewTerraformDestroyLister(indexer cache.Indexer) TerraformDestroyLister {
+	return &terraformDestroyLister{indexer: indexer}
+}
+
+// List lists all TerraformDestroys in the indexer.
+func (s *terraformDestroyLister) List(selector labels.Selector) (ret []*v1alpha2.TerraformDestroy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.TerraformDestroy))
+	})
+	return ret, err
+}
+
+// TerraformDestroys returns an object that can list and get TerraformDestroys.
+func (s *terraformDestroyLister) TerraformDestroys(namespace string) TerraformDestroyNamespaceLister {
+	return terraformDestroyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TerraformDestroyNamespaceLister helps list and get TerraformDestroys.
+type TerraformDestroyNamespaceLister interface {
+	// List lists all TerraformDestroys in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha2.TerraformDestroy, err error)
+	// Get retrieves the TerraformDestroy from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha2.TerraformDestroy, error)
+	TerraformDestroyNamespaceListerExpansion
+}
+
+// terraformDestroyNamespaceLister implements the TerraformDestroyNamespaceLister
+// interface.
+type terraformDestroyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TerraformDestroys in the indexer for a given namespace.
+func (s terraformDestroyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha2.TerraformDestroy, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.TerraformDestroy))
+	})
+	return ret, err
+}
+
+// Get retrieves the TerraformDestroy from the indexer for a given namespace and name.
+func (s terraformDestroyNamespaceLister) Get(name string) (*v1alpha2.TerraformDestroy, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha2.Resource("terraformdestroy"), name)
+	}
+	return obj.(*v1alpha2.TerraformDestroy), nil
+}
@@ -0,0 +1,78 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/danisla/terraform-operator/pkg/apis/ctl.isla.solutions/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TerraformPlanLister helps list TerraformPlans.
+type TerraformPlanLister interface {
+	// List lists all TerraformPlans in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha2.TerraformPlan, err error)
+	// TerraformPlans returns an object that can list and get TerraformPlans.
+	TerraformPlans(namespace string) TerraformPlanNamespaceLister
+	TerraformPlanListerExpansion
+}
+
+// terraformPlanLister implements the TerraformPlanLister interface.
+type terraformPlanLister struct {
+	indexer cache.Indexer
+}
+
+// NewTerraformPlanLister returns a new TerraformPlanLister.
+func NewTerraformPlanLister(indexer cache.Indexer) TerraformPlanLister {
+	return &terraformPlanLister{indexer: indexer}
+}
+
+// List lists all TerraformPlans in the indexer.
+func (s *terraformPlanLister) List(selector labels.Selector) (ret []*v1alpha2.TerraformPlan, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.TerraformPlan))
+	})
+	return ret, err
+}
+
+// TerraformPlans returns an object that can list and get TerraformPlans.
+func (s *terraformPlanLister) TerraformPlans(namespace string) TerraformPlanNamespaceLister {
+	return terraformPlanNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TerraformPlanNamespaceLister helps list and get TerraformPlans.
+type TerraformPlanNamespaceLister interface {
+	// List lists all TerraformPlans in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha2.TerraformPlan, err error)
+	// Get retrieves the TerraformPlan from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha2.TerraformPlan, error)
+	TerraformPlanNamespaceListerExpansion
+}
+
+// terraformPlanNamespaceLister implements the TerraformPlanNamespaceLister
+// interface.
+type terraformPlanNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TerraformPlans in the indexer for a given namespace.
+func (s terraformPlanNamespaceLister) List(selector labels.Selector) (ret []*v1alpha2.TerraformPlan, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.TerraformPlan))
+	})
+	return ret, err
+}
+
+// Get retrieves the TerraformPlan from the indexer for a given namespace and name.
+func (s terraformPlanNamespaceLister) Get(name string) (*v1alpha2.TerraformPlan, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha2.Resource("terraformplan"), name)
+	}
+	return obj.(*v1alpha2.TerraformPlan), nil
+}
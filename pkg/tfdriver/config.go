@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 )
@@ -22,6 +23,8 @@ type TerraformDriverConfig struct {
 	BackendPrefix              string
 	MaxAttempts                int32
 	BackoffScale               float64
+	PodTimeout                 time.Duration
+	MaxBackoff                 time.Duration
 	GoogleProviderConfigSecret string
 	PodCmdPlan                 string
 	PodCmdApply                string
@@ -97,6 +100,34 @@ func (c *TerraformDriverConfig) LoadAndValidate(project string) error {
 		c.BackoffScale = 5.0
 	}
 
+	// TF_MAX_BACKOFF is optional
+	if maxBackoff, ok := os.LookupEnv("TF_MAX_BACKOFF"); ok == true {
+		d, err := time.ParseDuration(maxBackoff)
+		if err != nil {
+			return fmt.Errorf("Invalid duration for TF_MAX_BACKOFF: %s, must be a valid duration", maxBackoff)
+		}
+		if d <= 0 {
+			return fmt.Errorf("Invalid duration for TF_MAX_BACKOFF: %s, must be greater than 0", maxBackoff)
+		}
+
+		c.MaxBackoff = d
+	} else {
+		c.MaxBackoff = 10 * time.Minute
+	}
+
+	// TF_POD_TIMEOUT is optional
+	if podTimeout, ok := os.LookupEnv("TF_POD_TIMEOUT"); ok == true {
+		d, err := time.ParseDuration(podTimeout)
+		if err != nil {
+			return fmt.Errorf("Invalid duration for TF_POD_TIMEOUT: %s, must be a valid duration", podTimeout)
+		}
+		if d <= 0 {
+			return fmt.Errorf("Invalid duration for TF_POD_TIMEOUT: %s, must be greater than 0", podTimeout)
+		}
+
+		c.PodTimeout = d
+	}
+
 	// TF_POD_PLAN_CMD is optional
 	if podCmd, ok := os.LookupEnv("TF_POD_PLAN_CMD"); ok == true {
 		c.PodCmdPlan = podCmd
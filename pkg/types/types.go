@@ -5,10 +5,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/robfig/cron"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// API group and version of the custom resources.
+const (
+	Group   = "ctl.isla.solutions"
+	Version = "v1"
 )
 
 // TFKind is an enum on the different kind of resources.
@@ -43,6 +54,29 @@ func (k *TFKind) GetShort() TFKindShort {
 	return s
 }
 
+// GetPlural returns the plural resource name of the kind, as defined in the CRD.
+func (k *TFKind) GetPlural() string {
+	var p string
+	switch *k {
+	case TFKindPlan:
+		p = "terraformplans"
+	case TFKindApply:
+		p = "terraformapplys"
+	case TFKindDestroy:
+		p = "terraformdestroys"
+	}
+	return p
+}
+
+// GetGroupVersionResource returns the resource used to access the kind through the API server.
+func (k *TFKind) GetGroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    Group,
+		Version:  Version,
+		Resource: k.GetPlural(),
+	}
+}
+
 // TerraformOperatorState represents the string mapping of the possible controller states. See the const definition below for enumerated states.
 type TerraformOperatorState string
 
@@ -75,6 +109,14 @@ func (parent *Terraform) Verify() error {
 	if parent.Spec != nil {
 		if err := parent.Spec.Verify(); err == nil {
 
+			if parent.Spec.DestroyOnDelete && parent.GetTFKind() != TFKindApply {
+				return fmt.Errorf("'spec.destroyOnDelete' is only supported for %s", TFKindApply)
+			}
+
+			if parent.Spec.Schedule != "" && parent.GetTFKind() == TFKindDestroy {
+				return fmt.Errorf("'spec.schedule' is not supported for %s", TFKindDestroy)
+			}
+
 			// Verify no cycles in TF sources
 			for _, s := range parent.Spec.Sources {
 				if s.TFApply != "" {
@@ -110,10 +152,39 @@ func (parent *Terraform) Log(level, msgfmt string, fmtargs ...interface{}) {
 	log.Printf("[%s][%s][%s] %s", level, parent.Kind, parent.Name, fmt.Sprintf(msgfmt, fmtargs...))
 }
 
-// GetSig returns a hash of the current parent spec.
+// GetSig returns a hash of the spec fields that are inputs of the terraform run.
+// Operational fields like the schedule, retry policy, timeout or pod template are not part of the signature,
+// changing them does not start a new run.
 func (parent *Terraform) GetSig() string {
+	var runSpec interface{}
+	if spec := parent.Spec; spec != nil {
+		runSpec = struct {
+			Image          string                         `json:"image,omitempty"`
+			BackendBucket  string                         `json:"backendBucket,omitempty"`
+			BackendPrefix  string                         `json:"backendPrefix,omitempty"`
+			Backend        *TerraformBackend              `json:"backend,omitempty"`
+			ProviderConfig *[]TerraformSpecProviderConfig `json:"providerConfig,omitempty"`
+			Sources        []TerraformConfigSource        `json:"sources,omitempty"`
+			TFPlan         string                         `json:"tfplan,omitempty"`
+			TFInputs       *[]TerraformConfigInputs       `json:"tfinputs,omitempty"`
+			TFVars         *[]TFVar                       `json:"tfvars,omitempty"`
+			TFVarsFrom     *[]TerraformConfigVarsFrom     `json:"tfvarsFrom,omitempty"`
+		}{
+			Image:          spec.Image,
+			BackendBucket:  spec.BackendBucket,
+			BackendPrefix:  spec.BackendPrefix,
+			Backend:        spec.Backend,
+			ProviderConfig: spec.ProviderConfig,
+			Sources:        spec.Sources,
+			TFPlan:         spec.TFPlan,
+			TFInputs:       spec.TFInputs,
+			TFVars:         spec.TFVars,
+			TFVarsFrom:     spec.TFVarsFrom,
+		}
+	}
+
 	hasher := sha1.New()
-	data, err := json.Marshal(&parent.Spec)
+	data, err := json.Marshal(runSpec)
 	if err != nil {
 		parent.Log("ERROR", "Failed to convert parent spec to JSON, this is a bug.")
 		return ""
@@ -164,8 +235,11 @@ func (parent *Terraform) GetConditionOrder() []ConditionType {
 		ConditionInputsReady,
 		ConditionVarsFromReady,
 		ConditionPlanReady,
+		ConditionPolicyPassed,
+		ConditionApproved,
 		ConditionPodComplete,
 		ConditionReady,
+		ConditionDrifted,
 	}
 
 	conditionOrder := make([]ConditionType, 0)
@@ -189,6 +263,21 @@ func (parent *Terraform) GetConditionOrder() []ConditionType {
 			if c == ConditionPlanReady && parent.Spec.TFPlan == "" {
 				continue
 			}
+
+			// PolicyPassed conditional on spec for tfplan and policies.
+			if c == ConditionPolicyPassed && (parent.Spec.TFPlan == "" || len(parent.Spec.Policies) == 0) {
+				continue
+			}
+
+			// Approved conditional on spec for tfplan and required approval.
+			if c == ConditionApproved && (parent.Spec.TFPlan == "" || parent.Spec.Approval == nil || parent.Spec.Approval.Required == false) {
+				continue
+			}
+
+			// Drifted conditional on spec for drift detection of a TerraformApply.
+			if c == ConditionDrifted && (parent.Spec.DriftDetection == nil || parent.GetTFKind() != TFKindApply) {
+				continue
+			}
 		}
 
 		conditionOrder = append(conditionOrder, c)
@@ -198,21 +287,61 @@ func (parent *Terraform) GetConditionOrder() []ConditionType {
 
 // TerraformOperatorStatus is the status structure for the custom resource
 type TerraformOperatorStatus struct {
-	Sources        TerraformOperatorStatusSources `json:"sources,omitempty"`
-	PodName        string                         `json:"podName,omitempty"`
-	PodStatus      PodStatus                      `json:"podStatus,omitempty"`
-	StartedAt      string                         `json:"startedAt,omitempty"`
-	FinishedAt     string                         `json:"finishedAt,omitempty"`
-	Duration       string                         `json:"duration,omitempty"`
-	TFPlan         string                         `json:"planFile,omitempty"`
-	TFPlanDiff     *TerraformPlanFileSummary      `json:"planDiff,omitempty"`
-	TFOutput       *[]TerraformOutputVar          `json:"outputs,omitempty"`
-	TFOutputSecret string                         `json:"outputsSecret,omitempty"`
-	RetryCount     int32                          `json:"retryCount,omitempty"`
-	RetryNextAt    string                         `json:"retryNextAt,omitempty"`
-	Workspace      string                         `json:"workspace,omitempty"`
-	StateFile      string                         `json:"stateFile,omitempty"`
-	Conditions     []Condition                    `json:"conditions,omitempty"`
+	Sources            TerraformOperatorStatusSources `json:"sources,omitempty"`
+	PodName            string                         `json:"podName,omitempty"`
+	PodStatus          PodStatus                      `json:"podStatus,omitempty"`
+	StartedAt          string                         `json:"startedAt,omitempty"`
+	FinishedAt         string                         `json:"finishedAt,omitempty"`
+	Duration           string                         `json:"duration,omitempty"`
+	TFPlan             string                         `json:"planFile,omitempty"`
+	TFPlanHash         string                         `json:"planHash,omitempty"`
+	TFPlanDiff         *TerraformPlanFileSummary      `json:"planDiff,omitempty"`
+	TFOutput           *[]TerraformOutputVar          `json:"outputs,omitempty"`
+	TFOutputSecret     string                         `json:"outputsSecret,omitempty"`
+	RetryCount         int32                          `json:"retryCount,omitempty"`
+	RetryNextAt        string                         `json:"retryNextAt,omitempty"`
+	Workspace          string                         `json:"workspace,omitempty"`
+	StateFile          string                         `json:"stateFile,omitempty"`
+	Drift              *TerraformDriftStatus          `json:"drift,omitempty"`
+	LastScheduledRun   string                         `json:"lastScheduledRun,omitempty"`
+	NextScheduledRun   string                         `json:"nextScheduledRun,omitempty"`
+	RunSpecSig         string                         `json:"runSpecSig,omitempty"`
+	RunPlanFile        string                         `json:"runPlanFile,omitempty"`
+	RunPlanHash        string                         `json:"runPlanHash,omitempty"`
+	RunGeneration      int64                          `json:"runGeneration,omitempty"`
+	ObservedGeneration int64                          `json:"observedGeneration,omitempty"`
+	RunReason          string                         `json:"runReason,omitempty"`
+	Job                *TerraformJobStatus            `json:"job,omitempty"`
+	Conditions         []Condition                    `json:"conditions,omitempty"`
+}
+
+// TerraformJobStatus is the status of the Job for the current run when the spec.job is set.
+type TerraformJobStatus struct {
+	Name       string                 `json:"name,omitempty"`
+	Active     int32                  `json:"active,omitempty"`
+	Succeeded  int32                  `json:"succeeded,omitempty"`
+	Failed     int32                  `json:"failed,omitempty"`
+	Conditions []batchv1.JobCondition `json:"conditions,omitempty"`
+}
+
+// IsFinished returns true if the Job has a Complete or Failed condition.
+func (j *TerraformJobStatus) IsFinished() bool {
+	for _, c := range j.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// TerraformDriftStatus is the status of the last drift detection plan.
+type TerraformDriftStatus struct {
+	PodName       string                    `json:"podName,omitempty"`
+	PodStatus     PodStatus                 `json:"podStatus,omitempty"`
+	LastCheckedAt string                    `json:"lastCheckedAt,omitempty"`
+	NextCheckAt   string                    `json:"nextCheckAt,omitempty"`
+	Drifted       bool                      `json:"drifted,omitempty"`
+	PlanDiff      *TerraformPlanFileSummary `json:"planDiff,omitempty"`
 }
 
 // Condition defines the format for a status condition element.
@@ -255,15 +384,31 @@ const (
 	ConditionVarsFromReady ConditionType = "TFVarsFromReady"
 	// ConditionPlanReady is True when a given tfplan source file path is ready.
 	ConditionPlanReady ConditionType = "TFPlanReady"
+	// ConditionPolicyPassed is True when the plan from the given tfplan does not violate any of the policies.
+	ConditionPolicyPassed ConditionType = "PolicyPassed"
+	// ConditionApproved is True when the plan file and hash from the given tfplan have been approved.
+	ConditionApproved ConditionType = "Approved"
 	// ConditionPodComplete is True when the terraform pod has completed successfully.
 	ConditionPodComplete ConditionType = "TFPodComplete"
 	// ConditionReady is True when all prior conditions are ready.
 	ConditionReady ConditionType = "Ready"
+	// ConditionDrifted is True when the last drift detection plan found changes to the applied infrastructure.
+	// It is informational and not considered by the Ready condition.
+	ConditionDrifted ConditionType = "Drifted"
 )
 
 // GetDependencies returns a map of condition type names to an ordered slice of dependent condition types.
 func (conditionType *ConditionType) GetDependencies() []ConditionType {
 	switch *conditionType {
+	case ConditionPolicyPassed:
+		return []ConditionType{
+			ConditionPlanReady,
+		}
+	case ConditionApproved:
+		return []ConditionType{
+			ConditionPlanReady,
+			ConditionPolicyPassed,
+		}
 	case ConditionPodComplete:
 		return []ConditionType{
 			ConditionProviderConfigReady,
@@ -271,6 +416,12 @@ func (conditionType *ConditionType) GetDependencies() []ConditionType {
 			ConditionInputsReady,
 			ConditionVarsFromReady,
 			ConditionPlanReady,
+			ConditionPolicyPassed,
+			ConditionApproved,
+		}
+	case ConditionDrifted:
+		return []ConditionType{
+			ConditionPodComplete,
 		}
 	}
 	return []ConditionType{}
@@ -295,17 +446,28 @@ func (conditions Conditions) CheckConditions(conditionType ConditionType) error
 
 // TerraformSpec is the top level structure of the spec body
 type TerraformSpec struct {
-	Image           string                         `json:"image,omitempty"`
-	ImagePullPolicy corev1.PullPolicy              `json:"imagePullPolicy,omitempty"`
-	BackendBucket   string                         `json:"backendBucket,omitempty"`
-	BackendPrefix   string                         `json:"backendPrefix,omitempty"`
-	ProviderConfig  *[]TerraformSpecProviderConfig `json:"providerConfig,omitempty"`
-	Sources         []TerraformConfigSource        `json:"sources,omitempty"`
-	TFPlan          string                         `json:"tfplan,omitempty"`
-	TFInputs        *[]TerraformConfigInputs       `json:"tfinputs,omitempty"`
-	TFVars          *[]TFVar                       `json:"tfvars,omitempty"`
-	TFVarsFrom      *[]TerraformConfigVarsFrom     `json:"tfvarsFrom,omitempty"`
-	MaxAttempts     *int32                         `json:"maxAttempts,omitempty"`
+	Image             string                         `json:"image,omitempty"`
+	ImagePullPolicy   corev1.PullPolicy              `json:"imagePullPolicy,omitempty"`
+	BackendBucket     string                         `json:"backendBucket,omitempty"`
+	BackendPrefix     string                         `json:"backendPrefix,omitempty"`
+	Backend           *TerraformBackend              `json:"backend,omitempty"`
+	ProviderConfig    *[]TerraformSpecProviderConfig `json:"providerConfig,omitempty"`
+	Sources           []TerraformConfigSource        `json:"sources,omitempty"`
+	TFPlan            string                         `json:"tfplan,omitempty"`
+	Approval          *TerraformApproval             `json:"approval,omitempty"`
+	Policies          []TerraformPolicySource        `json:"policies,omitempty"`
+	TFInputs          *[]TerraformConfigInputs       `json:"tfinputs,omitempty"`
+	TFVars            *[]TFVar                       `json:"tfvars,omitempty"`
+	TFVarsFrom        *[]TerraformConfigVarsFrom     `json:"tfvarsFrom,omitempty"`
+	MaxAttempts       *int32                         `json:"maxAttempts,omitempty"`
+	DriftDetection    *TerraformDriftDetection       `json:"driftDetection,omitempty"`
+	Schedule          string                         `json:"schedule,omitempty"`
+	DestroyOnDelete   bool                           `json:"destroyOnDelete,omitempty"`
+	Job               *TerraformJob                  `json:"job,omitempty"`
+	PodTemplate       *corev1.PodTemplateSpec        `json:"podTemplate,omitempty"`
+	Timeout           string                         `json:"timeout,omitempty"`
+	RetryPolicy       *TerraformRetryPolicy          `json:"retryPolicy,omitempty"`
+	OutputsSecretOnly bool                           `json:"outputsSecretOnly,omitempty"`
 }
 
 // TerraformSpecFrom is the the top level structure of specifying spec from antoher Terraform resource
@@ -326,9 +488,259 @@ func (spec *TerraformSpec) Verify() error {
 		return fmt.Errorf("Missing 'spec.sources'")
 	}
 
+	if len(spec.Policies) > 0 && spec.TFPlan == "" {
+		return fmt.Errorf("'spec.policies' requires 'spec.tfplan'")
+	}
+
+	if spec.Schedule != "" {
+		if _, err := cron.ParseStandard(spec.Schedule); err != nil {
+			return fmt.Errorf("Invalid 'spec.schedule': %v", err)
+		}
+
+		// A saved plan is stale after it was applied once.
+		if spec.TFPlan != "" {
+			return fmt.Errorf("'spec.schedule' cannot be used with 'spec.tfplan'")
+		}
+	}
+
+	if spec.Timeout != "" {
+		if d, err := time.ParseDuration(spec.Timeout); err != nil {
+			return fmt.Errorf("Invalid 'spec.timeout': %v", err)
+		} else if d < time.Second {
+			return fmt.Errorf("'spec.timeout' must be at least 1s")
+		}
+	}
+
+	if spec.Job != nil && spec.MaxAttempts != nil {
+		return fmt.Errorf("'spec.maxAttempts' cannot be used with 'spec.job', use 'spec.job.backoffLimit'")
+	}
+
+	if spec.RetryPolicy != nil {
+		if spec.Job != nil {
+			return fmt.Errorf("'spec.retryPolicy' cannot be used with 'spec.job', use 'spec.job.backoffLimit'")
+		}
+		if spec.MaxAttempts != nil && spec.RetryPolicy.MaxAttempts != nil {
+			return fmt.Errorf("'spec.maxAttempts' cannot be used with 'spec.retryPolicy.maxAttempts'")
+		}
+		if err := spec.RetryPolicy.Verify(); err != nil {
+			return err
+		}
+	}
+
+	if spec.PodTemplate != nil {
+		if p := spec.PodTemplate.Spec.RestartPolicy; p != "" && p != corev1.RestartPolicyNever {
+			return fmt.Errorf("'spec.podTemplate.spec.restartPolicy' must be %s", corev1.RestartPolicyNever)
+		}
+		for _, c := range append(spec.PodTemplate.Spec.InitContainers, spec.PodTemplate.Spec.Containers...) {
+			if c.Name == "" {
+				return fmt.Errorf("Missing container name in 'spec.podTemplate.spec'")
+			}
+		}
+	}
+
+	if spec.DriftDetection != nil {
+		if err := spec.DriftDetection.Verify(); err != nil {
+			return err
+		}
+
+		if spec.DriftDetection.AutoApply && spec.TFPlan != "" {
+			return fmt.Errorf("'spec.driftDetection.autoApply' cannot be used with 'spec.tfplan'")
+		}
+	}
+
+	if spec.Backend != nil {
+		if err := spec.Backend.Verify(); err != nil {
+			return err
+		}
+
+		if spec.Backend.Type != BackendGCS && (spec.BackendBucket != "" || spec.BackendPrefix != "") {
+			return fmt.Errorf("'spec.backendBucket' and 'spec.backendPrefix' are only valid with the %s backend", BackendGCS)
+		}
+	}
+
 	return nil
 }
 
+// RetryMode is what happens when a run fails more than the max attempts.
+type RetryMode string
+
+const (
+	// RetryModeContinuous keeps retrying, the backoff starts over after the max attempts.
+	RetryModeContinuous RetryMode = "Continuous"
+
+	// RetryModeFail stops retrying after the max attempts and leaves the run failed.
+	RetryModeFail RetryMode = "Fail"
+)
+
+// RetryAction is the action of a retry rule matching the error output of a failed run.
+type RetryAction string
+
+const (
+	// RetryActionRetry retries the run with the retry policy.
+	RetryActionRetry RetryAction = "Retry"
+
+	// RetryActionFail does not retry the run.
+	RetryActionFail RetryAction = "Fail"
+)
+
+// TerraformRetryPolicy controls how failed runs are retried.
+// The first rule with a pattern matching the error output decides if the failure is retryable, errors that match no rule are retried.
+type TerraformRetryPolicy struct {
+	MaxAttempts *int32               `json:"maxAttempts,omitempty"`
+	MaxBackoff  string               `json:"maxBackoff,omitempty"`
+	Mode        RetryMode            `json:"mode,omitempty"`
+	Rules       []TerraformRetryRule `json:"rules,omitempty"`
+}
+
+// TerraformRetryRule matches the error output of a failed run with a regular expression.
+type TerraformRetryRule struct {
+	Pattern string      `json:"pattern"`
+	Action  RetryAction `json:"action"`
+}
+
+// Verify checks the retry policy fields.
+func (p *TerraformRetryPolicy) Verify() error {
+	if p.MaxAttempts != nil && *p.MaxAttempts <= 0 {
+		return fmt.Errorf("'spec.retryPolicy.maxAttempts' must be a positive integer")
+	}
+
+	if p.MaxBackoff != "" {
+		if d, err := time.ParseDuration(p.MaxBackoff); err != nil {
+			return fmt.Errorf("Invalid 'spec.retryPolicy.maxBackoff': %v", err)
+		} else if d <= 0 {
+			return fmt.Errorf("'spec.retryPolicy.maxBackoff' must be greater than 0")
+		}
+	}
+
+	switch p.Mode {
+	case "", RetryModeContinuous, RetryModeFail:
+	default:
+		return fmt.Errorf("Invalid 'spec.retryPolicy.mode': %s, must be one of: %s, %s", p.Mode, RetryModeContinuous, RetryModeFail)
+	}
+
+	for i, rule := range p.Rules {
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("Invalid 'spec.retryPolicy.rules[%d].pattern': %v", i, err)
+		}
+		switch rule.Action {
+		case RetryActionRetry, RetryActionFail:
+		default:
+			return fmt.Errorf("Invalid 'spec.retryPolicy.rules[%d].action': %s, must be one of: %s, %s", i, rule.Action, RetryActionRetry, RetryActionFail)
+		}
+	}
+
+	return nil
+}
+
+// TerraformJob runs each terraform operation as a batch/v1 Job instead of a Pod.
+// Retries are handled by the Job controller using the backoffLimit instead of the maxAttempts.
+type TerraformJob struct {
+	BackoffLimit            *int32 `json:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds   *int64 `json:"activeDeadlineSeconds,omitempty"`
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// MinDriftDetectionInterval is the shortest allowed interval between drift detection plans.
+const MinDriftDetectionInterval = time.Minute
+
+// TerraformDriftDetection periodically runs a plan against the applied workspace to detect drift.
+// The interval is a duration string, for example: 30m or 12h.
+type TerraformDriftDetection struct {
+	Interval  string `json:"interval,omitempty"`
+	AutoApply bool   `json:"autoApply,omitempty"`
+}
+
+// Verify checks that the interval is a valid duration.
+func (d *TerraformDriftDetection) Verify() error {
+	interval, err := d.GetInterval()
+	if err != nil {
+		return fmt.Errorf("Invalid 'spec.driftDetection.interval': %v", err)
+	}
+	if interval < MinDriftDetectionInterval {
+		return fmt.Errorf("'spec.driftDetection.interval' must be at least %s", MinDriftDetectionInterval)
+	}
+	return nil
+}
+
+// GetInterval parses the interval duration.
+func (d *TerraformDriftDetection) GetInterval() (time.Duration, error) {
+	return time.ParseDuration(d.Interval)
+}
+
+// TerraformBackendType is the type of Terraform backend used to store the remote state.
+type TerraformBackendType string
+
+// Supported backend types, see: https://www.terraform.io/docs/backends/types/index.html
+const (
+	BackendGCS        TerraformBackendType = "gcs"
+	BackendS3         TerraformBackendType = "s3"
+	BackendAzureRM    TerraformBackendType = "azurerm"
+	BackendKubernetes TerraformBackendType = "kubernetes"
+	BackendHTTP       TerraformBackendType = "http"
+	BackendLocal      TerraformBackendType = "local"
+)
+
+// TerraformBackend is the spec for the Terraform backend, the config is rendered as the backend block arguments.
+type TerraformBackend struct {
+	Type   TerraformBackendType `json:"type,omitempty"`
+	Config map[string]string    `json:"config,omitempty"`
+}
+
+// Verify checks that the backend type is supported.
+func (backend *TerraformBackend) Verify() error {
+	switch backend.Type {
+	case BackendGCS, BackendS3, BackendAzureRM, BackendKubernetes, BackendHTTP, BackendLocal:
+		return nil
+	case "":
+		return fmt.Errorf("Missing 'spec.backend.type'")
+	}
+	return fmt.Errorf("Unsupported 'spec.backend.type': %s", backend.Type)
+}
+
+// SupportsWorkspaces returns true if the backend type supports multiple named workspaces.
+func (backend *TerraformBackend) SupportsWorkspaces() bool {
+	return backend.Type != BackendHTTP
+}
+
+// Annotations used to approve a plan for a TerraformApply with approval required.
+const (
+	AnnotationApprovedPlanFile = "ctl.isla.solutions/approved-plan-file"
+	AnnotationApprovedPlanHash = "ctl.isla.solutions/approved-plan-hash"
+)
+
+// AnnotationSkipDestroyOnDelete skips the destroy of a TerraformApply with spec.destroyOnDelete when set to "true",
+// the finalizer is removed without destroying the resources.
+const AnnotationSkipDestroyOnDelete = "ctl.isla.solutions/skip-destroy-on-delete"
+
+// TerraformApproval is the spec for requiring approval of the plan given by the tfplan field before it is applied.
+// The approval is given by setting the plan file and plan hash, from the status, in this spec or in the approval annotations.
+type TerraformApproval struct {
+	Required bool   `json:"required,omitempty"`
+	PlanFile string `json:"planFile,omitempty"`
+	PlanHash string `json:"planHash,omitempty"`
+}
+
+// TerraformPolicySource references a ConfigMap where each key is a plan policy document in YAML format.
+type TerraformPolicySource struct {
+	ConfigMap string `json:"configMap,omitempty"`
+}
+
+// TerraformPlanPolicy is a set of rules evaluated against the plan before it is applied.
+type TerraformPlanPolicy struct {
+	Rules []TerraformPlanPolicyRule `json:"rules,omitempty"`
+}
+
+// TerraformPlanPolicyRule limits the number of planned resource changes matching the resource types and actions.
+// Resource types may contain shell patterns, empty resource types or actions match all.
+// The default maxCount of 0 denies any matching change.
+type TerraformPlanPolicyRule struct {
+	Name          string       `json:"name,omitempty"`
+	ResourceTypes []string     `json:"resourceTypes,omitempty"`
+	Actions       []PlanAction `json:"actions,omitempty"`
+	MaxCount      int          `json:"maxCount,omitempty"`
+	Message       string       `json:"message,omitempty"`
+}
+
 // TFVar is an element of the TFVars spec
 type TFVar struct {
 	Name  string `json:"name,omitempty"`
@@ -383,9 +795,48 @@ type TerraformOperatorStatusSources struct {
 
 // TerraformPlanFileSummary summarizes the changes in a terraform plan
 type TerraformPlanFileSummary struct {
-	Added     int `json:"added"`
-	Changed   int `json:"changed"`
-	Destroyed int `json:"destroyed"`
+	Added     int                           `json:"added"`
+	Changed   int                           `json:"changed"`
+	Destroyed int                           `json:"destroyed"`
+	Replaced  int                           `json:"replaced"`
+	Resources []TerraformPlanResourceChange `json:"resources,omitempty"`
+}
+
+// PlanAction is the action terraform will take on a resource.
+type PlanAction string
+
+// Plan actions, replace is a destroy and create of the same resource.
+const (
+	PlanActionCreate  PlanAction = "create"
+	PlanActionUpdate  PlanAction = "update"
+	PlanActionDelete  PlanAction = "delete"
+	PlanActionReplace PlanAction = "replace"
+)
+
+// TerraformPlanResourceChange is the planned change to a single resource.
+type TerraformPlanResourceChange struct {
+	Address    string     `json:"address"`
+	Module     string     `json:"module,omitempty"`
+	Type       string     `json:"type,omitempty"`
+	Action     PlanAction `json:"action"`
+	Attributes []string   `json:"attributes,omitempty"`
+}
+
+// AddResource adds the resource change to the summary and increments the count for the action.
+func (summary *TerraformPlanFileSummary) AddResource(r TerraformPlanResourceChange) {
+	switch r.Action {
+	case PlanActionCreate:
+		summary.Added++
+	case PlanActionUpdate:
+		summary.Changed++
+	case PlanActionDelete:
+		summary.Destroyed++
+	case PlanActionReplace:
+		summary.Replaced++
+	default:
+		return
+	}
+	summary.Resources = append(summary.Resources, r)
 }
 
 // ConfigMapKeys is an ordered list of source keys as they appeard in the spec.
@@ -397,8 +848,9 @@ type GCSObjects []string
 
 // ConfigMapHash is an element holding the configmap source name and a hash of the data spec.
 type ConfigMapHash struct {
-	Name string `json:"name,omitempty"`
-	Hash string `json:"hash,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Hash         string `json:"hash,omitempty"`
+	PreviousHash string `json:"previousHash,omitempty"`
 }
 
 // EmbeddedConfigMaps is a list of ConfigMap names generated to hold the embedded source.
@@ -410,8 +862,12 @@ type TerraformOutputVar struct {
 	Sensitive bool   `json:"sensitive,omitempty"`
 	Type      string `json:"type,omitempty"`
 	Value     string `json:"value,omitempty"`
+	Hash      string `json:"hash,omitempty"`
 }
 
+// AnnotationOutputsHashSalt is the annotation of the outputs Secret with the salt of the sensitive output hashes in the status.
+const AnnotationOutputsHashSalt = "ctl.isla.solutions/outputs-hash-salt"
+
 // PodStatus is a const enum
 type PodStatus string
 
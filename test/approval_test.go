@@ -0,0 +1,74 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestApproval runs a tfplan then a tfapply that waits for approval of the planfile before applying it.
+func TestApproval(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-approval"
+
+	testApplyTFSourceConfigMap(t, namespace, name)
+	defer testDeleteTFSourceConfigMap(t, namespace, name)
+
+	// Create tfplan
+	tfplan := testMakeTF(t, tfSpecData{
+		Kind:             TFKindPlan,
+		Name:             name,
+		ConfigMapSources: []string{name},
+	})
+	defer testDelete(t, namespace, tfplan)
+	t.Log(tfplan)
+	testApply(t, namespace, tfplan)
+	testWaitTF(t, TFKindPlan, namespace, name)
+
+	// Create tfapply
+	tfapply := testMakeTF(t, tfSpecData{
+		Kind:             TFKindApply,
+		Name:             name,
+		ConfigMapSources: []string{name},
+		TFPlan:           name,
+		ApprovalRequired: true,
+	})
+	t.Log(tfapply)
+	testApply(t, namespace, tfapply)
+	defer testDelete(t, namespace, tfapply)
+
+	// Wait for the plan to be shown in the status.
+	var tf Terraform
+	maxTime := time.Now().Add(time.Minute * time.Duration(timeout))
+	for time.Now().Before(maxTime) {
+		tf = testGetTF(t, TFKindApply, namespace, name)
+		if tf.Status.PlanHash != "" {
+			break
+		}
+		fmt.Printf("Waiting for %s/%s plan hash\n", TFKindApply, name)
+		time.Sleep(time.Second * time.Duration(5))
+	}
+	assert(t, tf.Status.PlanHash != "", "plan hash not found in status")
+	assert(t, tf.Status.PodName == "", "pod created before approval: %s", tf.Status.PodName)
+
+	approved := tf.GetCondition(ConditionApproved)
+	assert(t, approved != nil, "condition not found in status: %s", ConditionApproved)
+	assert(t, approved.Status != ConditionTrue, "condition %s is True before approval", ConditionApproved)
+
+	// Approve the plan
+	testRunCmd(t, fmt.Sprintf("kubectl -n %s annotate %s %s ctl.isla.solutions/approved-plan-file=%s ctl.isla.solutions/approved-plan-hash=%s", namespace, TFKindApply, name, tf.Status.PlanFile, tf.Status.PlanHash), "")
+
+	tf = testWaitTF(t, TFKindApply, namespace, name)
+	tf.VerifyConditions(t, []ConditionType{
+		ConditionProviderConfigReady,
+		ConditionSourceReady,
+		ConditionPlanReady,
+		ConditionApproved,
+		ConditionPodComplete,
+		ConditionReady,
+	})
+
+	// Create tfdestroy
+	testConfigMapSourceTF(t, TFKindDestroy, name, name, true)
+}
@@ -0,0 +1,42 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestDestroyOnDelete runs a tfapply with destroyOnDelete and verifies that deleting it waits for the destroy.
+func TestDestroyOnDelete(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-destroy-on-delete"
+
+	testApplyTFSourceConfigMap(t, namespace, name)
+	defer testDeleteTFSourceConfigMap(t, namespace, name)
+
+	tfapply := testMakeTF(t, tfSpecData{
+		Kind:             TFKindApply,
+		Name:             name,
+		ConfigMapSources: []string{name},
+		TFVars: map[string]string{
+			"metadata_key": name,
+		},
+		DestroyOnDelete: true,
+	})
+	t.Log(tfapply)
+	testApply(t, namespace, tfapply)
+	tf := testWaitTF(t, TFKindApply, namespace, name)
+	tf.VerifyConditions(t, []ConditionType{
+		ConditionPodComplete,
+		ConditionProviderConfigReady,
+		ConditionSourceReady,
+		ConditionReady,
+	})
+
+	// Delete waits for the finalizer to be removed after the destroy pod completes.
+	testRunCmd(t, fmt.Sprintf("kubectl -n %s delete %s %s --timeout=%dm", namespace, TFKindApply, name, timeout), "")
+
+	out := testRunCmd(t, fmt.Sprintf("kubectl -n %s get %s %s --ignore-not-found", namespace, TFKindApply, name), "")
+	assert(t, strings.TrimSpace(out) == "", "%s/%s still exists after delete", TFKindApply, name)
+}
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestSpecChange runs a tfapply, changes the tfvars and verifies that the apply is run again in a new pod.
+func TestSpecChange(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-spec-change"
+
+	testApplyTFSourceConfigMap(t, namespace, name)
+	defer testDeleteTFSourceConfigMap(t, namespace, name)
+
+	tfapply := testConfigMapSourceTF(t, TFKindApply, name, name, false)
+	tf := testGetTF(t, TFKindApply, namespace, name)
+	firstPod := tf.Status.PodName
+
+	// Change the tfvars
+	changed := testMakeTF(t, tfSpecData{
+		Kind:             TFKindApply,
+		Name:             name,
+		ConfigMapSources: []string{name},
+		TFVars: map[string]string{
+			"metadata_key": fmt.Sprintf("%s-changed", name),
+		},
+	})
+	t.Log(changed)
+	testApply(t, namespace, changed)
+	defer testDelete(t, namespace, tfapply)
+
+	// Wait for the new pod
+	maxTime := time.Now().Add(time.Minute * time.Duration(timeout))
+	for time.Now().Before(maxTime) {
+		tf = testGetTF(t, TFKindApply, namespace, name)
+		if tf.Status.PodName != firstPod {
+			break
+		}
+		fmt.Printf("Waiting for %s/%s to start new pod\n", TFKindApply, name)
+		time.Sleep(time.Second * time.Duration(5))
+	}
+	assert(t, tf.Status.PodName != firstPod, "new pod not created after spec change")
+
+	tf = testWaitTF(t, TFKindApply, namespace, name)
+	tf.VerifyConditions(t, []ConditionType{
+		ConditionPodComplete,
+		ConditionProviderConfigReady,
+		ConditionSourceReady,
+		ConditionReady,
+	})
+	equals(t, tf.Status.ObservedGeneration, tf.Status.RunGeneration)
+
+	// Create tfdestroy
+	testConfigMapSourceTF(t, TFKindDestroy, name, name, true)
+}
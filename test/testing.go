@@ -41,6 +41,8 @@ type tfSpecData struct {
 	GoogleProviderSecretName string
 	TFVars                   map[string]string
 	TFPlan                   string
+	ApprovalRequired         bool
+	DestroyOnDelete          bool
 	TFVarsFrom               []TFSource
 	TFInputs                 []TFInput
 }
@@ -65,6 +67,7 @@ type TerraformOutputVar struct {
 	Sensitive bool   `json:"sensitive,omitempty"`
 	Type      string `json:"type,omitempty"`
 	Value     string `json:"value,omitempty"`
+	Hash      string `json:"hash,omitempty"`
 }
 
 type Terraform struct {
@@ -72,10 +75,14 @@ type Terraform struct {
 }
 
 type TerraformStatus struct {
-	PodName    string               `json:"podName"`
-	PodStatus  string               `json:"podStatus"`
-	Outputs    []TerraformOutputVar `json:"outputs,omitempty"`
-	Conditions []Condition          `json:"conditions,omitempty"`
+	PodName            string               `json:"podName"`
+	PodStatus          string               `json:"podStatus"`
+	PlanFile           string               `json:"planFile,omitempty"`
+	PlanHash           string               `json:"planHash,omitempty"`
+	RunGeneration      int64                `json:"runGeneration,omitempty"`
+	ObservedGeneration int64                `json:"observedGeneration,omitempty"`
+	Outputs            []TerraformOutputVar `json:"outputs,omitempty"`
+	Conditions         []Condition          `json:"conditions,omitempty"`
 }
 
 type ConditionType string
@@ -87,6 +94,7 @@ const (
 	ConditionTFInputsReady       ConditionType = "TFInputsReady"
 	ConditionVarsFromReady       ConditionType = "TFVarsFromReady"
 	ConditionPlanReady           ConditionType = "TFPlanReady"
+	ConditionApproved            ConditionType = "Approved"
 	ConditionPodComplete         ConditionType = "TFPodComplete"
 	ConditionReady               ConditionType = "Ready"
 )
@@ -120,6 +128,16 @@ func (tf *Terraform) VerifyOutputVars(t *testing.T) {
 	assert(t, allFound, "Incomplete output vars found in status.")
 }
 
+// GetCondition returns the condition of the given type from the status, or nil if it was not found.
+func (tf *Terraform) GetCondition(conditionType ConditionType) *Condition {
+	for _, c := range tf.Status.Conditions {
+		if c.Type == conditionType {
+			return &c
+		}
+	}
+	return nil
+}
+
 func (tf *Terraform) VerifyConditions(t *testing.T, conditions []ConditionType) {
 	assert(t, len(conditions) == len(tf.Status.Conditions), "Different number of conditions found: %d, expected: %d", len(tf.Status.Conditions), len(conditions))
 	for _, condition := range conditions {